@@ -0,0 +1,3 @@
+-- Optimistic concurrency: every update bumps the version, which is exposed as the ETag.
+ALTER TABLE starwar.character
+    ADD COLUMN IF NOT EXISTS version integer NOT NULL DEFAULT 1;
@@ -0,0 +1,17 @@
+-- created and edited are managed by the server, stored as timestamptz instead of client supplied text.
+-- the text was free-form, a value that is not a timestamp becomes the time of the migration.
+CREATE FUNCTION pg_temp.to_timestamptz_or_now(value text) RETURNS timestamptz AS $$
+BEGIN
+    RETURN COALESCE(NULLIF(btrim(value), '')::timestamptz, now());
+EXCEPTION WHEN others THEN
+    RETURN now();
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE starwar.character
+    ALTER COLUMN created TYPE timestamptz USING pg_temp.to_timestamptz_or_now(created),
+    ALTER COLUMN created SET DEFAULT now(),
+    ALTER COLUMN created SET NOT NULL,
+    ALTER COLUMN edited TYPE timestamptz USING pg_temp.to_timestamptz_or_now(edited),
+    ALTER COLUMN edited SET DEFAULT now(),
+    ALTER COLUMN edited SET NOT NULL;
@@ -0,0 +1,12 @@
+-- height (cm) and mass (kg) become nullable numbers, NULL stands for SWAPI "unknown".
+-- like the domain parser, the thousands separators and surrounding spaces are ignored, any text that is not a number is unknown.
+ALTER TABLE starwar.character
+    ALTER COLUMN height TYPE numeric USING CASE
+        WHEN btrim(replace(height, ',', '')) ~ '^[0-9]+(\.[0-9]+)?$' THEN btrim(replace(height, ',', ''))::numeric
+    END,
+    ALTER COLUMN mass TYPE numeric USING CASE
+        WHEN btrim(replace(mass, ',', '')) ~ '^[0-9]+(\.[0-9]+)?$' THEN btrim(replace(mass, ',', ''))::numeric
+    END;
+
+CREATE INDEX IF NOT EXISTS character_height_idx ON starwar.character (height);
+CREATE INDEX IF NOT EXISTS character_mass_idx ON starwar.character (mass);
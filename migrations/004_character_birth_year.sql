@@ -0,0 +1,12 @@
+-- birth_year keeps the SWAPI text, birth_year_value the signed year relative to the Battle of Yavin (BBY negative).
+ALTER TABLE starwar.character
+    ADD COLUMN IF NOT EXISTS birth_year_value numeric;
+
+UPDATE starwar.character
+SET birth_year_value = CASE
+        WHEN upper(birth_year) ~ '^[0-9]+(\.[0-9]+)?\s*BBY$' THEN -substring(birth_year FROM '^[0-9]+(?:\.[0-9]+)?')::numeric
+        WHEN upper(birth_year) ~ '^[0-9]+(\.[0-9]+)?\s*ABY$' THEN substring(birth_year FROM '^[0-9]+(?:\.[0-9]+)?')::numeric
+    END
+WHERE birth_year_value IS NULL;
+
+CREATE INDEX IF NOT EXISTS character_birth_year_value_idx ON starwar.character (birth_year_value);
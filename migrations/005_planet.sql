@@ -0,0 +1,28 @@
+-- planets are their own resource, characters reference them through homeworld_id.
+CREATE TABLE IF NOT EXISTS starwar.planet (
+    id              serial PRIMARY KEY,
+    name            text NOT NULL,
+    rotation_period text,
+    orbital_period  text,
+    diameter        text,
+    climate         text,
+    gravity         text,
+    terrain         text,
+    surface_water   text,
+    population      text,
+    created         timestamptz NOT NULL DEFAULT now(),
+    edited          timestamptz NOT NULL DEFAULT now(),
+    url             text UNIQUE,
+    version         integer NOT NULL DEFAULT 1
+);
+
+ALTER TABLE starwar.character
+    ADD COLUMN IF NOT EXISTS homeworld_id integer REFERENCES starwar.planet (id) ON DELETE SET NULL;
+
+UPDATE starwar.character c
+SET homeworld_id = p.id
+FROM starwar.planet p
+WHERE p.url = c.homewor_ld
+  AND c.homeworld_id IS NULL;
+
+CREATE INDEX IF NOT EXISTS character_homeworld_id_idx ON starwar.character (homeworld_id);
@@ -0,0 +1,98 @@
+-- films, species, vehicles and starships, linked to characters through one join table each.
+CREATE TABLE IF NOT EXISTS starwar.film (
+    id            serial PRIMARY KEY,
+    title         text NOT NULL,
+    episode_id    integer,
+    opening_crawl text,
+    director      text,
+    producer      text,
+    release_date  text,
+    created       timestamptz NOT NULL DEFAULT now(),
+    edited        timestamptz NOT NULL DEFAULT now(),
+    url           text UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS starwar.species (
+    id               serial PRIMARY KEY,
+    name             text NOT NULL,
+    classification   text,
+    designation      text,
+    average_height   text,
+    skin_colors      text,
+    hair_colors      text,
+    eye_colors       text,
+    average_lifespan text,
+    homeworld        text,
+    language         text,
+    created          timestamptz NOT NULL DEFAULT now(),
+    edited           timestamptz NOT NULL DEFAULT now(),
+    url              text UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS starwar.vehicle (
+    id                     serial PRIMARY KEY,
+    name                   text NOT NULL,
+    model                  text,
+    manufacturer           text,
+    cost_in_credits        text,
+    length                 text,
+    max_atmosphering_speed text,
+    crew                   text,
+    passengers             text,
+    cargo_capacity         text,
+    consumables            text,
+    vehicle_class          text,
+    created                timestamptz NOT NULL DEFAULT now(),
+    edited                 timestamptz NOT NULL DEFAULT now(),
+    url                    text UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS starwar.starship (
+    id                     serial PRIMARY KEY,
+    name                   text NOT NULL,
+    model                  text,
+    manufacturer           text,
+    cost_in_credits        text,
+    length                 text,
+    max_atmosphering_speed text,
+    crew                   text,
+    passengers             text,
+    cargo_capacity         text,
+    consumables            text,
+    hyperdrive_rating      text,
+    mglt                   text,
+    starship_class         text,
+    created                timestamptz NOT NULL DEFAULT now(),
+    edited                 timestamptz NOT NULL DEFAULT now(),
+    url                    text UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS starwar.character_film (
+    character_id integer NOT NULL REFERENCES starwar.character (id) ON DELETE CASCADE,
+    film_id      integer NOT NULL REFERENCES starwar.film (id) ON DELETE CASCADE,
+    PRIMARY KEY (character_id, film_id)
+);
+
+CREATE TABLE IF NOT EXISTS starwar.character_species (
+    character_id integer NOT NULL REFERENCES starwar.character (id) ON DELETE CASCADE,
+    species_id   integer NOT NULL REFERENCES starwar.species (id) ON DELETE CASCADE,
+    PRIMARY KEY (character_id, species_id)
+);
+
+CREATE TABLE IF NOT EXISTS starwar.character_vehicle (
+    character_id integer NOT NULL REFERENCES starwar.character (id) ON DELETE CASCADE,
+    vehicle_id   integer NOT NULL REFERENCES starwar.vehicle (id) ON DELETE CASCADE,
+    PRIMARY KEY (character_id, vehicle_id)
+);
+
+CREATE TABLE IF NOT EXISTS starwar.character_starship (
+    character_id integer NOT NULL REFERENCES starwar.character (id) ON DELETE CASCADE,
+    starship_id  integer NOT NULL REFERENCES starwar.starship (id) ON DELETE CASCADE,
+    PRIMARY KEY (character_id, starship_id)
+);
+
+-- the reverse lookups, /films/{id}/characters and friends.
+CREATE INDEX IF NOT EXISTS character_film_film_id_idx ON starwar.character_film (film_id);
+CREATE INDEX IF NOT EXISTS character_species_species_id_idx ON starwar.character_species (species_id);
+CREATE INDEX IF NOT EXISTS character_vehicle_vehicle_id_idx ON starwar.character_vehicle (vehicle_id);
+CREATE INDEX IF NOT EXISTS character_starship_starship_id_idx ON starwar.character_starship (starship_id);
@@ -0,0 +1,17 @@
+-- full text and fuzzy search on characters, the name weighs more than the descriptive fields.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE starwar.character
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('simple',
+            COALESCE(gender, '') || ' ' ||
+            COALESCE(hair_color, '') || ' ' ||
+            COALESCE(skin_color, '') || ' ' ||
+            COALESCE(eye_color, '')), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS character_search_vector_idx ON starwar.character USING gin (search_vector);
+
+-- backs the word similarity operator used for misspelled names.
+CREATE INDEX IF NOT EXISTS character_name_trgm_idx ON starwar.character USING gin (name gin_trgm_ops);
@@ -0,0 +1,6 @@
+-- a resource without url stores NULL, so any number of them fit the unique url constraint.
+UPDATE starwar.planet SET url = NULL WHERE url = '';
+UPDATE starwar.film SET url = NULL WHERE url = '';
+UPDATE starwar.species SET url = NULL WHERE url = '';
+UPDATE starwar.vehicle SET url = NULL WHERE url = '';
+UPDATE starwar.starship SET url = NULL WHERE url = '';
@@ -0,0 +1,69 @@
+// Command server serves the function with net/http, to run it as a plain container or locally without the OpenFaaS watchdog.
+// It reads the same configuration as the function, the server_* keys configure the listener.
+package main
+
+import (
+	"context"
+	"errors"
+	"handler/function"
+	"handler/function/internal/config"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func main() {
+	serverConfig, err := config.LoadServer(config.DefaultSources())
+	if err != nil {
+		log.Fatalf("Error loading server configuration %s\n", err.Error())
+	}
+
+	lifecycleConfig, err := config.LoadLifecycle(config.DefaultSources())
+	if err != nil {
+		log.Fatalf("Error loading lifecycle configuration %s\n", err.Error())
+	}
+
+	server := newServer(serverConfig, http.HandlerFunc(function.Handle))
+
+	go func() {
+		log.Printf("Server listening on %s, tls %t\n", server.Addr, serverConfig.TlsEnabled())
+		if serveErr := listen(server, serverConfig); !errors.Is(serveErr, http.ErrServerClosed) {
+			log.Fatalf("Server error %s\n", serveErr.Error())
+		}
+	}()
+
+	// the function shuts itself down on the same signals, the server stops accepting connections meanwhile.
+	channel := make(chan os.Signal, 2)
+	signal.Notify(channel, syscall.SIGINT, syscall.SIGTERM)
+	<-channel
+
+	ctx, cancel := context.WithTimeout(context.Background(), lifecycleConfig.ShutdownGracePeriod)
+	defer cancel()
+
+	if shutdownErr := server.Shutdown(ctx); shutdownErr != nil {
+		log.Printf("Server shutdown error %s\n", shutdownErr.Error())
+	}
+
+	<-function.Closed()
+	log.Println("Server stopped")
+}
+
+func newServer(c *config.ServerConfig, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              c.Address,
+		Handler:           handler,
+		ReadTimeout:       c.ReadTimeout,
+		ReadHeaderTimeout: c.ReadTimeout,
+		WriteTimeout:      c.WriteTimeout,
+		IdleTimeout:       c.IdleTimeout,
+	}
+}
+
+func listen(server *http.Server, c *config.ServerConfig) error {
+	if c.TlsEnabled() {
+		return server.ListenAndServeTLS(c.TlsCertFile, c.TlsKeyFile)
+	}
+	return server.ListenAndServe()
+}
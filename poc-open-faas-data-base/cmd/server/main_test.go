@@ -0,0 +1,28 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"handler/function/internal/config"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewServer(t *testing.T) {
+	serverConfig := &config.ServerConfig{Address: ":9000", ReadTimeout: time.Second, WriteTimeout: 2 * time.Second, IdleTimeout: 3 * time.Second}
+
+	server := newServer(serverConfig, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	assert.EqualValues(t, ":9000", server.Addr)
+	assert.EqualValues(t, time.Second, server.ReadTimeout)
+	assert.EqualValues(t, time.Second, server.ReadHeaderTimeout)
+	assert.EqualValues(t, 2*time.Second, server.WriteTimeout)
+	assert.EqualValues(t, 3*time.Second, server.IdleTimeout)
+
+	rec := httptest.NewRecorder()
+	server.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.EqualValues(t, http.StatusTeapot, rec.Result().StatusCode)
+}
@@ -4,103 +4,654 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
 	"handler/function/internal/adapter/chache"
 	cacheModel "handler/function/internal/adapter/chache/model"
+	"handler/function/internal/adapter/clock"
 	"handler/function/internal/adapter/controller"
+	"handler/function/internal/adapter/graph"
+	"handler/function/internal/adapter/metrics"
+	"handler/function/internal/adapter/openapi"
+	openapiModel "handler/function/internal/adapter/openapi/model"
 	"handler/function/internal/adapter/respository"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"handler/function/internal/adapter/rpc"
+	"handler/function/internal/adapter/tracing"
+	tracingModel "handler/function/internal/adapter/tracing/model"
+	"handler/function/internal/adapter/upstream"
+	upstreamModel "handler/function/internal/adapter/upstream/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"handler/function/internal/application/usecase/diagnostic"
 	"handler/function/internal/application/usecase/starwar"
+	"handler/function/internal/config"
+	"handler/function/internal/lifecycle"
 	"log"
+	"math"
 	"net/http"
 	"os"
 	"os/signal"
 	"regexp"
-	"strconv"
+	"sync"
 	"syscall"
 	"time"
 )
 
 var routes = map[string]func(http.ResponseWriter, *http.Request){}
 
+// routesMutex guards routes, they are published by the initialization while requests are already served.
+var routesMutex = sync.RWMutex{}
+
+var readiness = diagnostic.NewCheckReadinessUseCase(clock.NewSystemClock())
+
+var functionMetrics = metrics.NewMetrics()
+
+var tracer = otel.Tracer("handler/function")
+
+// untracedRoutes are called by the platform every few seconds, their spans would only be noise.
+var untracedRoutes = map[string]bool{"GET /healthz": true, "GET /readyz": true, "GET /metrics": true}
+
+// tracerShutdownTimeout bounds the export of the spans left when the function shuts down.
+const tracerShutdownTimeout = 5 * time.Second
+
+// dependencyPingTimeout bounds the check of the dependencies in every initialization attempt.
+const dependencyPingTimeout = 5 * time.Second
+
+// the resources linked to characters share their routes, the captured group is the resource name.
+var linkedResourceCollection = regexp.MustCompile("^/api/v1/starwar/(films|species|vehicles|starships)$")
+var linkedResourceDetail = regexp.MustCompile("^/api/v1/starwar/(films|species|vehicles|starships)/[0-9]+$")
+var linkedResourceCharacters = regexp.MustCompile("^/api/v1/starwar/(films|species|vehicles|starships)/[0-9]+/characters$")
+
+// swapi.dev compatible paths, the trailing slash is optional as clients follow the swapi.dev urls.
+var swapiPeopleCollection = regexp.MustCompile("^/api/people/?$")
+var swapiPeopleDetail = regexp.MustCompile("^/api/people/[0-9]+/?$")
+var characterLinkedResources = regexp.MustCompile("^/api/v1/starwar/characters/[0-9]+/(films|species|vehicles|starships)$")
+
+// functionLifecycle initializes the function once, requests to routes that are not published yet wait for it.
+var functionLifecycle *lifecycle.Lifecycle
+
+// functionShutdown tracks the requests in flight and the clients opened by the initialization.
+var functionShutdown = lifecycle.NewShutdown()
+
 func init() {
-	go func() {
+	// the probes are served from the start, the initialization below can take a while or fail.
+	healthController := controller.NewHealthController(readiness)
+	routes["GET /healthz"] = healthController.Liveness
+	routes["GET /metrics"] = functionMetrics.Handler().ServeHTTP
+
+	// the traceparent of the requests is propagated even when no spans are exported.
+	otel.SetTextMapPropagator(tracing.Propagator())
+
+	lifecycleConfig, err := config.LoadLifecycle(config.DefaultSources())
+	if err != nil {
+		log.Printf("Error loading lifecycle configuration, using the defaults %s\n", err.Error())
+		lifecycleConfig, _ = config.LoadLifecycle(&config.Sources{})
+	}
+
+	// the initialization, eager or lazy, stops retrying once a shutdown cancels ctx.
+	ctx, stopInitialization := context.WithCancel(context.Background())
+
+	functionLifecycle = lifecycle.New(initialize, &lifecycle.Options{
+		WaitTimeout:     lifecycleConfig.WaitTimeout,
+		RetryBackoff:    lifecycleConfig.RetryBackoff,
+		RetryMaxBackoff: lifecycleConfig.RetryMaxBackoff,
+	}, ctx)
+
+	// a lazy function initializes with the first request that needs it, or the first readiness probe,
+	// otherwise an instance behind a readiness gate would never receive that request.
+	routes["GET /readyz"] = func(w http.ResponseWriter, r *http.Request) {
+		functionLifecycle.Start()
+		healthController.Readiness(w, r)
+	}
+
+	if lifecycleConfig.Mode == config.StartupEager {
+		functionLifecycle.Start()
+	}
+
+	go awaitShutdown(lifecycleConfig.ShutdownGracePeriod, stopInitialization)
+}
+
+// Closed is closed once the function shut down and closed its clients, a standalone server waits for it before exiting.
+func Closed() <-chan struct{} {
+	return functionShutdown.Done()
+}
+
+// awaitShutdown stops the retries of the initialization and shuts the function down on SIGINT or SIGTERM.
+func awaitShutdown(gracePeriod time.Duration, stopInitialization context.CancelFunc) {
+	channel := make(chan os.Signal, 2)
+	signal.Notify(channel, syscall.SIGINT, syscall.SIGTERM)
 
-		databaseUrl := os.Getenv("database_url")
-		dataBasePool, err := pgxpool.New(context.Background(), databaseUrl)
+	received := <-channel
+	log.Printf("Received %s, shutting down with a grace period of %s\n", received, gracePeriod)
+
+	stopInitialization()
+	functionShutdown.Shutdown(gracePeriod)
+}
+
+// initialize builds and publishes the routes, an error releases what was opened so the lifecycle can retry.
+func initialize(ctx context.Context) (err error) {
+
+	functionConfig, configErr := config.Load(config.DefaultSources())
+	if configErr != nil {
+		return fmt.Errorf("loading configuration: %s", configErr.Error())
+	}
+
+	log.Printf("Configuration %s\n", functionConfig)
+
+	poolOptions := getPoolOptions(functionConfig)
+	poolOptions.QueryObserver = functionMetrics
+
+	dataBasePool, err := respository.NewPool(functionConfig.Database.Url.Value(), poolOptions, ctx)
+	if err != nil {
+		return fmt.Errorf("data base connection: %s", err.Error())
+	}
+	defer func() {
 		if err != nil {
-			log.Printf("Data base connection error %s\n", err.Error())
-			return
+			dataBasePool.Close()
 		}
+	}()
 
-		repositoryAdapter, dataBaseErr := respository.NewStarwarRepositoryAdapter(dataBasePool)
-		if dataBaseErr != nil {
-			log.Println("Error initializing repository adapter")
-			return
+	repositoryAdapter, dataBaseErr := respository.NewStarwarRepositoryAdapter(dataBasePool)
+	if dataBaseErr != nil {
+		return fmt.Errorf("initializing repository adapter: %s", dataBaseErr.Error())
+	}
+
+	planetRepositoryAdapter, planetDataBaseErr := respository.NewPlanetRepositoryAdapter(dataBasePool)
+	if planetDataBaseErr != nil {
+		return fmt.Errorf("initializing planet repository adapter: %s", planetDataBaseErr.Error())
+	}
+
+	filmRepositoryAdapter, filmDataBaseErr := respository.NewFilmRepositoryAdapter(dataBasePool)
+	if filmDataBaseErr != nil {
+		return fmt.Errorf("initializing film repository adapter: %s", filmDataBaseErr.Error())
+	}
+
+	speciesRepositoryAdapter, speciesDataBaseErr := respository.NewSpeciesRepositoryAdapter(dataBasePool)
+	if speciesDataBaseErr != nil {
+		return fmt.Errorf("initializing species repository adapter: %s", speciesDataBaseErr.Error())
+	}
+
+	vehicleRepositoryAdapter, vehicleDataBaseErr := respository.NewVehicleRepositoryAdapter(dataBasePool)
+	if vehicleDataBaseErr != nil {
+		return fmt.Errorf("initializing vehicle repository adapter: %s", vehicleDataBaseErr.Error())
+	}
+
+	starshipRepositoryAdapter, starshipDataBaseErr := respository.NewStarshipRepositoryAdapter(dataBasePool)
+	if starshipDataBaseErr != nil {
+		return fmt.Errorf("initializing starship repository adapter: %s", starshipDataBaseErr.Error())
+	}
+
+	cacheOptions := &cacheModel.CacheOptions{
+		Ttl:      functionConfig.Cache.Ttl,
+		Observer: functionMetrics,
+	}
+
+	// statistics have their own ttl, the character one is kept when it is not set.
+	statsCacheOptions := &cacheModel.CacheOptions{
+		Ttl:      functionConfig.Cache.StatsTtl,
+		Observer: functionMetrics,
+	}
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:      functionConfig.Cache.Url,
+		Password:  functionConfig.Cache.Password.Value(),
+		TLSConfig: &tls.Config{},
+	})
+	redisClient.AddHook(chache.NewTracingHook())
+	defer func() {
+		if err != nil {
+			redisClient.Close()
 		}
+	}()
 
-		cacheUrl := os.Getenv("cache_url")
-		cachePassword := os.Getenv("cache_password")
-		ttl := os.Getenv("cache_ttl")
+	redisAdapter, cacheErr := chache.NewStarwarRedisAdapter(redisClient, cacheOptions)
 
-		ttlMillisecond, ttlErr := strconv.Atoi(ttl)
+	if cacheErr != nil {
+		return fmt.Errorf("initializing cache adapter: %s", cacheErr.Error())
+	}
 
-		if ttlErr != nil {
-			log.Printf("Error parsing cache ttl %s\n", ttlErr.Error())
-			return
+	planetRedisAdapter, planetCacheErr := chache.NewPlanetRedisAdapter(redisClient, cacheOptions)
+
+	if planetCacheErr != nil {
+		return fmt.Errorf("initializing planet cache adapter: %s", planetCacheErr.Error())
+	}
+
+	characterSource, sourceErr := getCharacterSource(functionConfig)
+	if sourceErr != nil {
+		return fmt.Errorf("initializing character source: %s", sourceErr.Error())
+	}
+
+	statsRedisAdapter, statsCacheErr := chache.NewCharacterStatsRedisAdapter(redisClient, statsCacheOptions)
+
+	if statsCacheErr != nil {
+		return fmt.Errorf("initializing character stats cache adapter: %s", statsCacheErr.Error())
+	}
+
+	systemClock := clock.NewSystemClock()
+
+	createCharacter := tracing.NewTracedCreateCharacter(starwar.NewCreateCharacterUseCase(repositoryAdapter, repositoryAdapter, systemClock))
+	findCharacter := tracing.NewTracedFindCharacter(starwar.NewFindCharacterUseCase(repositoryAdapter, redisAdapter, characterSource, repositoryAdapter))
+	updateCharacter := starwar.NewUpdateCharacterUseCase(repositoryAdapter, redisAdapter, repositoryAdapter, systemClock)
+
+	listCharacters := starwar.NewListCharactersUseCase(repositoryAdapter)
+	searchCharacters := starwar.NewSearchCharactersUseCase(repositoryAdapter)
+	findCharacterStats := starwar.NewFindCharacterStatsUseCase(repositoryAdapter, statsRedisAdapter)
+
+	createPlanet := starwar.NewCreatePlanetUseCase(planetRepositoryAdapter, systemClock)
+	findPlanet := starwar.NewFindPlanetUseCase(planetRepositoryAdapter, planetRedisAdapter)
+	updatePlanet := starwar.NewUpdatePlanetUseCase(planetRepositoryAdapter, planetRedisAdapter, systemClock)
+	deletePlanet := starwar.NewDeletePlanetUseCase(planetRepositoryAdapter, planetRedisAdapter)
+
+	listLinkedCharacters := starwar.NewListLinkedCharactersUseCase(repositoryAdapter)
+
+	filmUseCase := starwar.NewLinkedResourceUseCase[*model.Film, *model.FilmIdentifier, *model.FilmDetail](filmRepositoryAdapter, systemClock)
+	filmController := controller.NewFilmController(filmUseCase, filmUseCase, filmUseCase, listLinkedCharacters)
+	speciesUseCase := starwar.NewLinkedResourceUseCase[*model.Species, *model.SpeciesIdentifier, *model.SpeciesDetail](speciesRepositoryAdapter, systemClock)
+	speciesController := controller.NewSpeciesController(speciesUseCase, speciesUseCase, speciesUseCase, listLinkedCharacters)
+	vehicleUseCase := starwar.NewLinkedResourceUseCase[*model.Vehicle, *model.VehicleIdentifier, *model.VehicleDetail](vehicleRepositoryAdapter, systemClock)
+	vehicleController := controller.NewVehicleController(vehicleUseCase, vehicleUseCase, vehicleUseCase, listLinkedCharacters)
+	starshipUseCase := starwar.NewLinkedResourceUseCase[*model.Starship, *model.StarshipIdentifier, *model.StarshipDetail](starshipRepositoryAdapter, systemClock)
+	starshipController := controller.NewStarshipController(starshipUseCase, starshipUseCase, starshipUseCase, listLinkedCharacters)
+
+	findDiagnostic := diagnostic.NewFindDiagnosticUseCase(repositoryAdapter)
+
+	starwarsController := controller.NewStarWarController(createCharacter, findCharacter, updateCharacter, listCharacters, findPlanet)
+	characterSearchController := controller.NewCharacterSearchController(searchCharacters)
+	characterStatsController := controller.NewCharacterStatsController(findCharacterStats)
+	planetController := controller.NewPlanetController(createPlanet, findPlanet, updatePlanet, deletePlanet)
+	swapiController := controller.NewSwapiController(findCharacter, listCharacters, controller.SwapiLinkOptions{
+		BaseUrl:               functionConfig.Swapi.BaseUrl,
+		TrustForwardedHeaders: functionConfig.Swapi.TrustForwardedHeaders,
+	})
+	diagnosticController := controller.NewDiagnosticController(findDiagnostic)
+
+	handlers := map[string]func(http.ResponseWriter, *http.Request){}
+	handlers["POST /characters"] = starwarsController.CreateStarWarCharacter
+	handlers["GET /characters"] = starwarsController.ListStarWarCharacters
+	handlers["GET /characters/"] = starwarsController.FindStarWarCharacter
+	handlers["GET /characters/search"] = characterSearchController.SearchStarWarCharacters
+	handlers["GET /characters/stats"] = characterStatsController.FindCharacterStats
+	handlers["PUT /characters/"] = starwarsController.UpdateStarWarCharacter
+	handlers["POST /planets"] = planetController.CreatePlanet
+	handlers["GET /planets/"] = planetController.FindPlanet
+	handlers["PUT /planets/"] = planetController.UpdatePlanet
+	handlers["DELETE /planets/"] = planetController.DeletePlanet
+	handlers["POST /films"] = filmController.Create
+	handlers["GET /films/"] = filmController.Find
+	handlers["GET /films/characters"] = filmController.ListResourceCharacters
+	handlers["GET /characters/films"] = filmController.ListCharacterResources
+	handlers["POST /species"] = speciesController.Create
+	handlers["GET /species/"] = speciesController.Find
+	handlers["GET /species/characters"] = speciesController.ListResourceCharacters
+	handlers["GET /characters/species"] = speciesController.ListCharacterResources
+	handlers["POST /vehicles"] = vehicleController.Create
+	handlers["GET /vehicles/"] = vehicleController.Find
+	handlers["GET /vehicles/characters"] = vehicleController.ListResourceCharacters
+	handlers["GET /characters/vehicles"] = vehicleController.ListCharacterResources
+	handlers["POST /starships"] = starshipController.Create
+	handlers["GET /starships/"] = starshipController.Find
+	handlers["GET /starships/characters"] = starshipController.ListResourceCharacters
+	handlers["GET /characters/starships"] = starshipController.ListCharacterResources
+	handlers["GET /people"] = swapiController.ListPeople
+	handlers["GET /people/"] = swapiController.FindPerson
+	handlers["GET /diagnostics/database"] = diagnosticController.FindDatabaseStatistics
+
+	// every route answers in the media type of the Accept header and reads the one of the Content-Type,
+	// and only keeps the client timestamps of an import when the request has the import token.
+	for route, handler := range handlers {
+		handlers[route] = controller.ImportAuthorized(functionConfig.Import.Token.Value(), controller.Negotiated(handler))
+	}
+
+	// requests, and responses in test environments, are checked against the OpenAPI document when enabled.
+	validator, validatorErr := openapi.NewValidator(&openapiModel.ValidatorOptions{
+		Requests:  functionConfig.Openapi.ValidateRequests,
+		Responses: functionConfig.Openapi.ValidateResponses,
+	}, ctx)
+	if validatorErr != nil {
+		return fmt.Errorf("loading openapi specification: %s", validatorErr.Error())
+	}
+
+	for route, handler := range handlers {
+		handlers[route] = validator.Validated(handler)
+	}
+
+	handlers["GET /openapi.json"] = openapi.NewSpecificationController().FindSpecification
+
+	// GraphQL always answers json, it is registered after the negotiated routes.
+	graphqlController, graphqlErr := graph.NewGraphqlController(graph.NewResolver(
+		createCharacter,
+		findCharacter,
+		starwar.NewFindCharactersByIdsUseCase(repositoryAdapter),
+		listCharacters))
+	if graphqlErr != nil {
+		return fmt.Errorf("parsing graphql schema: %s", graphqlErr.Error())
+	}
+	handlers["POST /graphql"] = graphqlController.Query
+
+	dependencies := map[string]out.DependencyPinger{
+		"database": repositoryAdapter,
+		"cache":    redisAdapter,
+	}
+
+	// the pool and the client connect lazily, the routes are not published until both answer.
+	if pingErr := pingDependencies(dependencies, ctx); pingErr != nil {
+		return pingErr
+	}
+
+	// the gRPC api is only served when a port is configured, next to the HTTP routes.
+	var grpcServer *grpc.Server
+	if grpcPort := functionConfig.Grpc.Port; grpcPort != 0 {
+		grpcServer = rpc.NewServer(rpc.NewCharacterService(createCharacter, findCharacter, listCharacters))
+		if serveErr := rpc.Serve(grpcServer, grpcPort); serveErr != nil {
+			return fmt.Errorf("starting grpc server: %s", serveErr.Error())
 		}
+		// the next attempt listens on the same port, it is released when a later step fails.
+		defer func() {
+			if err != nil {
+				grpcServer.Stop()
+			}
+		}()
+	}
 
-		cacheOptions := &cacheModel.CacheOptions{
-			Ttl: time.Duration(ttlMillisecond) * time.Millisecond,
+	// the spans are exported from here on, the tracing api is a no-op until a provider is set.
+	if functionConfig.Tracing.Exporter != tracingModel.ExporterNone {
+		tracerProvider, tracingErr := tracing.NewTracerProvider(&tracingModel.TracingOptions{
+			Exporter:     functionConfig.Tracing.Exporter,
+			ServiceName:  functionConfig.Tracing.ServiceName,
+			OtlpEndpoint: functionConfig.Tracing.OtlpEndpoint,
+			OtlpInsecure: functionConfig.Tracing.OtlpInsecure,
+		}, ctx)
+		if tracingErr != nil {
+			return fmt.Errorf("initializing tracing: %s", tracingErr.Error())
 		}
+		otel.SetTracerProvider(tracerProvider)
 
-		redisClient := redis.NewClient(&redis.Options{
-			Addr:      cacheUrl,
-			Password:  cachePassword,
-			TLSConfig: &tls.Config{},
+		functionShutdown.OnClose("tracer provider", func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), tracerShutdownTimeout)
+			defer cancel()
+			return tracerProvider.Shutdown(ctx)
 		})
+	}
 
-		redisAdapter, cacheErr := chache.NewStarwarRedisAdapter(redisClient, cacheOptions)
+	// the servers drain before the clients they use are closed, the cache before the database.
+	if grpcServer != nil {
+		functionShutdown.OnDrain("grpc server", func(ctx context.Context) {
+			rpc.Drain(grpcServer, ctx)
+		})
+	}
+	functionShutdown.OnClose("cache client", redisClient.Close)
+	functionShutdown.OnClose("database pool", func() error {
+		dataBasePool.Close()
+		return nil
+	})
 
-		if cacheErr != nil {
-			log.Println("Error initializing cache adapter")
-			return
+	publishRoutes(handlers)
+	readiness.CompleteInitialization(dependencies)
+	functionMetrics.ObservePool(dataBasePool)
+
+	log.Println("Server running")
+
+	return nil
+}
+
+// pingDependencies fails when a dependency does not answer within dependencyPingTimeout.
+func pingDependencies(dependencies map[string]out.DependencyPinger, ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, dependencyPingTimeout)
+	defer cancel()
+
+	for name, dependency := range dependencies {
+		if err := dependency.Ping(ctx); err != nil {
+			return fmt.Errorf("%s is not available: %s", name, err.Error())
 		}
+	}
 
-		createCharacter := starwar.NewCreateCharacterUseCase(repositoryAdapter)
-		findCharacter := starwar.NewFindCharacterUseCase(repositoryAdapter, redisAdapter)
+	return nil
+}
 
-		starwarsController := controller.NewStarWarController(createCharacter, findCharacter)
+func getPoolOptions(c *config.Config) *repositoryModel.PoolOptions {
+	return &repositoryModel.PoolOptions{
+		MaxConns:          int32(c.Database.MaxConns),
+		MinConns:          int32(c.Database.MinConns),
+		MaxConnLifetime:   c.Database.MaxConnLifetime,
+		MaxConnIdleTime:   c.Database.MaxConnIdleTime,
+		HealthCheckPeriod: c.Database.HealthCheckPeriod,
+	}
+}
 
-		routes["/characters"] = starwarsController.CreateStarWarCharacter
-		routes["/characters/"] = starwarsController.FindStarWarCharacter
+// getCharacterSource returns nil when the upstream source is disabled, so misses are not read through.
+func getCharacterSource(c *config.Config) (out.CharacterSource, error) {
+	if !c.CharacterSource.Enabled {
+		return nil, nil
+	}
 
-		channel := make(chan os.Signal, 2)
-		signal.Notify(channel, syscall.SIGINT, syscall.SIGTERM)
-		log.Println("Server running")
+	source, err := upstream.NewSwapiCharacterSource(&http.Client{}, &upstreamModel.SourceOptions{
+		BaseUrl:          c.CharacterSource.Url,
+		Timeout:          c.CharacterSource.Timeout,
+		FailureThreshold: c.CharacterSource.FailureThreshold,
+		OpenTimeout:      c.CharacterSource.OpenTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		<-channel
-		dataBasePool.Close()
+	return source, nil
+}
 
-		log.Println("Server shutdown")
-	}()
+// publishRoutes registers the routes built by the initialization at once.
+func publishRoutes(handlers map[string]func(http.ResponseWriter, *http.Request)) {
+	routesMutex.Lock()
+	defer routesMutex.Unlock()
+
+	for route, handler := range handlers {
+		routes[route] = handler
+	}
+}
+
+func findRoute(route string) func(http.ResponseWriter, *http.Request) {
+	routesMutex.RLock()
+	defer routesMutex.RUnlock()
+
+	return routes[route]
+}
+
+// statusRecorder keeps the status written by the handler for the metrics.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// serve waits for the initialization when the route is not published yet,
+// it answers 503 with Retry-After when the initialization does not complete in time.
+// Every request is recorded in the metrics of its route and traced, continuing the trace of its traceparent.
+func serve(route string, w http.ResponseWriter, r *http.Request) {
+	recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	defer func(start time.Time) {
+		functionMetrics.ObserveRequest(route, r.Method, recorder.status, time.Since(start))
+	}(time.Now())
+	w = recorder
+
+	if !untracedRoutes[route] {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracer.Start(ctx, route, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(
+			semconv.HTTPMethodKey.String(r.Method),
+			semconv.HTTPRouteKey.String(route)))
+		defer func() {
+			span.SetAttributes(semconv.HTTPStatusCodeKey.Int(recorder.status))
+			if recorder.status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(recorder.status))
+			}
+			span.End()
+		}()
+		r = r.WithContext(ctx)
+	}
+
+	handler := findRoute(route)
+
+	if handler == nil {
+		if err := functionLifecycle.Wait(r.Context()); err != nil {
+			writeNotReady(w, err)
+			return
+		}
+		handler = findRoute(route)
+	}
+
+	if handler == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(http.StatusText(http.StatusServiceUnavailable)))
+		return
+	}
+
+	handler(w, r)
+}
+
+func writeNotReady(w http.ResponseWriter, err error) {
+	if notReady, ok := err.(*lifecycle.NotReadyError); ok {
+		w.Header().Set("Retry-After", fmt.Sprintf("%d", int(math.Ceil(notReady.RetryAfter.Seconds()))))
+	}
+
+	w.WriteHeader(http.StatusServiceUnavailable)
+	w.Write([]byte(fmt.Sprintf("Service not ready: %s", err.Error())))
 }
 
 func Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	if !functionShutdown.Enter() {
+		w.Header().Set("Connection", "close")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("Service shutting down"))
+		return
+	}
+	defer functionShutdown.Leave()
+
+	if r.Method == http.MethodGet && r.URL.Path == "/healthz" {
+		serve("GET /healthz", w, r)
+		return
+	}
+
+	if r.Method == http.MethodGet && r.URL.Path == "/readyz" {
+		serve("GET /readyz", w, r)
+		return
+	}
+
+	if r.Method == http.MethodGet && r.URL.Path == "/metrics" {
+		serve("GET /metrics", w, r)
+		return
+	}
+
+	isCharacterDetail, _ := regexp.MatchString("^/api/v1/starwar/characters/[0-9]+$", r.URL.Path)
+	if r.Method == http.MethodGet && isCharacterDetail {
+		serve("GET /characters/", w, r)
+		return
+	}
 
-	isGetCharacterDetail, _ := regexp.MatchString("^/api/v1/starwar/characters/[0-9]+$", r.URL.Path)
-	if r.Method == http.MethodGet && isGetCharacterDetail {
-		routes["/characters/"](w, r)
+	if r.Method == http.MethodPut && isCharacterDetail {
+		serve("PUT /characters/", w, r)
 		return
 	}
 
-	isCreateCharacter, _ := regexp.MatchString("^/api/v1/starwar/characters$", r.URL.Path)
+	if r.Method == http.MethodGet && r.URL.Path == "/api/v1/starwar/characters/search" {
+		serve("GET /characters/search", w, r)
+		return
+	}
 
-	if r.Method == http.MethodPost && isCreateCharacter {
-		routes["/characters"](w, r)
+	if r.Method == http.MethodGet && r.URL.Path == "/api/v1/starwar/characters/stats" {
+		serve("GET /characters/stats", w, r)
 		return
 	}
 
+	isPlanetDetail, _ := regexp.MatchString("^/api/v1/starwar/planets/[0-9]+$", r.URL.Path)
+	if r.Method == http.MethodGet && isPlanetDetail {
+		serve("GET /planets/", w, r)
+		return
+	}
+
+	if r.Method == http.MethodPut && isPlanetDetail {
+		serve("PUT /planets/", w, r)
+		return
+	}
+
+	if r.Method == http.MethodDelete && isPlanetDetail {
+		serve("DELETE /planets/", w, r)
+		return
+	}
+
+	if r.Method == http.MethodPost && r.URL.Path == "/api/v1/starwar/planets" {
+		serve("POST /planets", w, r)
+		return
+	}
+
+	if match := linkedResourceCollection.FindStringSubmatch(r.URL.Path); r.Method == http.MethodPost && match != nil {
+		serve("POST /"+match[1], w, r)
+		return
+	}
+
+	if match := linkedResourceDetail.FindStringSubmatch(r.URL.Path); r.Method == http.MethodGet && match != nil {
+		serve("GET /"+match[1]+"/", w, r)
+		return
+	}
+
+	if match := linkedResourceCharacters.FindStringSubmatch(r.URL.Path); r.Method == http.MethodGet && match != nil {
+		serve("GET /"+match[1]+"/characters", w, r)
+		return
+	}
+
+	if match := characterLinkedResources.FindStringSubmatch(r.URL.Path); r.Method == http.MethodGet && match != nil {
+		serve("GET /characters/"+match[1], w, r)
+		return
+	}
+
+	if r.Method == http.MethodGet && swapiPeopleCollection.MatchString(r.URL.Path) {
+		serve("GET /people", w, r)
+		return
+	}
+
+	if r.Method == http.MethodGet && swapiPeopleDetail.MatchString(r.URL.Path) {
+		serve("GET /people/", w, r)
+		return
+	}
+
+	if r.Method == http.MethodGet && r.URL.Path == "/api/v1/diagnostics/database" {
+		serve("GET /diagnostics/database", w, r)
+		return
+	}
+
+	if r.Method == http.MethodGet && r.URL.Path == "/openapi.json" {
+		serve("GET /openapi.json", w, r)
+		return
+	}
+
+	if r.Method == http.MethodPost && r.URL.Path == "/graphql" {
+		serve("POST /graphql", w, r)
+		return
+	}
+
+	isCharacterCollection, _ := regexp.MatchString("^/api/v1/starwar/characters$", r.URL.Path)
+
+	if r.Method == http.MethodPost && isCharacterCollection {
+		serve("POST /characters", w, r)
+		return
+	}
+
+	if r.Method == http.MethodGet && isCharacterCollection {
+		serve("GET /characters", w, r)
+		return
+	}
+
+	// unknown paths share a route, so scanners do not create a series per path.
+	functionMetrics.ObserveRequest("unknown", r.Method, http.StatusNotFound, time.Since(start))
+
 	w.WriteHeader(http.StatusNotFound)
 	w.Write([]byte(fmt.Sprintf("Url: %s %s", r.URL.Path, http.StatusText(http.StatusNotFound))))
 }
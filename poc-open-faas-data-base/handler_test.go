@@ -2,12 +2,17 @@ package function
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+	"handler/function/internal/lifecycle"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 type testParam struct {
@@ -28,9 +33,105 @@ func mockCreateCharacter(w http.ResponseWriter, _ *http.Request) {
 
 }
 
+func mockUpdateCharacter(w http.ResponseWriter, _ *http.Request) {
+	log.Println("update character controller mock ok")
+	w.WriteHeader(http.StatusOK)
+
+}
+
+func mockListCharacters(w http.ResponseWriter, _ *http.Request) {
+	log.Println("list characters controller mock ok")
+	w.WriteHeader(http.StatusOK)
+
+}
+
+func mockSearchCharacters(w http.ResponseWriter, _ *http.Request) {
+	log.Println("search characters controller mock ok")
+	w.WriteHeader(http.StatusOK)
+
+}
+
+func mockCharacterStats(w http.ResponseWriter, _ *http.Request) {
+	log.Println("character stats controller mock ok")
+	w.WriteHeader(http.StatusOK)
+
+}
+
+func mockFindDatabaseStatistics(w http.ResponseWriter, _ *http.Request) {
+	log.Println("find database statistics controller mock ok")
+	w.WriteHeader(http.StatusOK)
+
+}
+
+func mockPlanet(w http.ResponseWriter, _ *http.Request) {
+	log.Println("planet controller mock ok")
+	w.WriteHeader(http.StatusOK)
+
+}
+
+func mockLinkedResource(w http.ResponseWriter, _ *http.Request) {
+	log.Println("linked resource controller mock ok")
+	w.WriteHeader(http.StatusOK)
+
+}
+
+func mockSwapiPeople(w http.ResponseWriter, _ *http.Request) {
+	log.Println("swapi people controller mock ok")
+	w.WriteHeader(http.StatusOK)
+
+}
+
+func mockGraphql(w http.ResponseWriter, _ *http.Request) {
+	log.Println("graphql controller mock ok")
+	w.WriteHeader(http.StatusOK)
+
+}
+
+func mockOpenapi(w http.ResponseWriter, _ *http.Request) {
+	log.Println("openapi controller mock ok")
+	w.WriteHeader(http.StatusOK)
+
+}
+
+func mockHealth(w http.ResponseWriter, _ *http.Request) {
+	log.Println("health controller mock ok")
+	w.WriteHeader(http.StatusOK)
+
+}
+
 func init() {
-	routes["/characters/"] = mockFindCharacter
-	routes["/characters"] = mockCreateCharacter
+	routes["GET /characters/"] = mockFindCharacter
+	routes["POST /characters"] = mockCreateCharacter
+	routes["PUT /characters/"] = mockUpdateCharacter
+	routes["GET /characters"] = mockListCharacters
+	routes["GET /characters/search"] = mockSearchCharacters
+	routes["GET /characters/stats"] = mockCharacterStats
+	routes["GET /diagnostics/database"] = mockFindDatabaseStatistics
+	routes["POST /planets"] = mockPlanet
+	routes["GET /planets/"] = mockPlanet
+	routes["PUT /planets/"] = mockPlanet
+	routes["DELETE /planets/"] = mockPlanet
+	routes["GET /people"] = mockSwapiPeople
+	routes["GET /people/"] = mockSwapiPeople
+	routes["POST /graphql"] = mockGraphql
+	routes["GET /openapi.json"] = mockOpenapi
+	routes["GET /healthz"] = mockHealth
+	routes["GET /readyz"] = mockHealth
+	for _, resource := range []string{"films", "species", "vehicles", "starships"} {
+		routes["POST /"+resource] = mockLinkedResource
+		routes["GET /"+resource+"/"] = mockLinkedResource
+		routes["GET /"+resource+"/characters"] = mockLinkedResource
+		routes["GET /characters/"+resource] = mockLinkedResource
+	}
+
+	// the mocks are the routes, the function is initialized without its dependencies.
+	functionLifecycle = initializedLifecycle()
+}
+
+func initializedLifecycle() *lifecycle.Lifecycle {
+	initialized := lifecycle.New(func(ctx context.Context) error { return nil }, &lifecycle.Options{WaitTimeout: time.Second}, context.Background())
+	initialized.Wait(context.Background())
+	return initialized
 }
 
 func TestUrlOk(t *testing.T) {
@@ -38,6 +139,28 @@ func TestUrlOk(t *testing.T) {
 	testCase := []testParam{
 		{testName: "find character", pathParam: "/api/v1/starwar/characters/1", method: http.MethodGet},
 		{testName: "create character", pathParam: "/api/v1/starwar/characters", method: http.MethodPost},
+		{testName: "list characters", pathParam: "/api/v1/starwar/characters", method: http.MethodGet},
+		{testName: "update character", pathParam: "/api/v1/starwar/characters/1", method: http.MethodPut},
+		{testName: "character stats", pathParam: "/api/v1/starwar/characters/stats?filter=height%20gt%20170", method: http.MethodGet},
+		{testName: "search characters", pathParam: "/api/v1/starwar/characters/search?q=skywlker", method: http.MethodGet},
+		{testName: "find database statistics", pathParam: "/api/v1/diagnostics/database", method: http.MethodGet},
+		{testName: "graphql", pathParam: "/graphql", method: http.MethodPost},
+		{testName: "openapi specification", pathParam: "/openapi.json", method: http.MethodGet},
+		{testName: "liveness", pathParam: "/healthz", method: http.MethodGet},
+		{testName: "readiness", pathParam: "/readyz", method: http.MethodGet},
+		{testName: "create planet", pathParam: "/api/v1/starwar/planets", method: http.MethodPost},
+		{testName: "find planet", pathParam: "/api/v1/starwar/planets/1", method: http.MethodGet},
+		{testName: "update planet", pathParam: "/api/v1/starwar/planets/1", method: http.MethodPut},
+		{testName: "delete planet", pathParam: "/api/v1/starwar/planets/1", method: http.MethodDelete},
+		{testName: "create film", pathParam: "/api/v1/starwar/films", method: http.MethodPost},
+		{testName: "find species", pathParam: "/api/v1/starwar/species/1", method: http.MethodGet},
+		{testName: "vehicle characters", pathParam: "/api/v1/starwar/vehicles/14/characters", method: http.MethodGet},
+		{testName: "character starships", pathParam: "/api/v1/starwar/characters/4/starships", method: http.MethodGet},
+		{testName: "character films", pathParam: "/api/v1/starwar/characters/4/films", method: http.MethodGet},
+		{testName: "swapi people", pathParam: "/api/people/", method: http.MethodGet},
+		{testName: "swapi people without slash", pathParam: "/api/people", method: http.MethodGet},
+		{testName: "swapi person", pathParam: "/api/people/1/", method: http.MethodGet},
+		{testName: "swapi person without slash", pathParam: "/api/people/1", method: http.MethodGet},
 	}
 
 	for _, param := range testCase {
@@ -66,8 +189,22 @@ func TestNotFound(t *testing.T) {
 		{testName: "find with incomplete url", pathParam: "/api/v1/starwar/characters/", method: http.MethodGet},
 		{testName: "find with url invalid", pathParam: "/api/v1/starwar/characters/_", method: http.MethodGet},
 		{testName: "find with invalid star url", pathParam: "/hola/mundo/api/v1/starwar/characters/_", method: http.MethodGet},
-		{testName: "find with invalid method", pathParam: "/api/v1/starwar/characters/1", method: http.MethodPut},
+		{testName: "find with invalid method", pathParam: "/api/v1/starwar/characters/1", method: http.MethodPatch},
+		{testName: "update with letter path param", pathParam: "/api/v1/starwar/characters/a", method: http.MethodPut},
 		{testName: "create with invalid method", pathParam: "/api/v1/starwar/characters", method: http.MethodPut},
+		{testName: "stats with invalid method", pathParam: "/api/v1/starwar/characters/stats", method: http.MethodPost},
+		{testName: "search with invalid method", pathParam: "/api/v1/starwar/characters/search", method: http.MethodPost},
+		{testName: "database statistics with invalid method", pathParam: "/api/v1/diagnostics/database", method: http.MethodPost},
+		{testName: "find planet with letter path param", pathParam: "/api/v1/starwar/planets/a", method: http.MethodGet},
+		{testName: "delete planet collection", pathParam: "/api/v1/starwar/planets", method: http.MethodDelete},
+		{testName: "unknown character relation", pathParam: "/api/v1/starwar/characters/4/pilots", method: http.MethodGet},
+		{testName: "film characters with invalid method", pathParam: "/api/v1/starwar/films/1/characters", method: http.MethodPost},
+		{testName: "list films", pathParam: "/api/v1/starwar/films", method: http.MethodGet},
+		{testName: "swapi person with letter", pathParam: "/api/people/a/", method: http.MethodGet},
+		{testName: "swapi people with invalid method", pathParam: "/api/people/", method: http.MethodPost},
+		{testName: "graphql with invalid method", pathParam: "/graphql", method: http.MethodGet},
+		{testName: "openapi specification with invalid method", pathParam: "/openapi.json", method: http.MethodPost},
+		{testName: "readiness with invalid method", pathParam: "/readyz", method: http.MethodPost},
 	}
 
 	for _, param := range testCase {
@@ -93,3 +230,75 @@ func TestNotFound(t *testing.T) {
 	}
 
 }
+
+func TestServiceNotReady(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/1", nil)
+	rec := httptest.NewRecorder()
+
+	serve("GET /not-registered", rec, req)
+
+	assert.EqualValues(t, http.StatusServiceUnavailable, rec.Result().StatusCode)
+}
+
+func TestServiceInitializing(t *testing.T) {
+	functionLifecycle = lifecycle.New(func(ctx context.Context) error {
+		return errors.New("database is not available")
+	}, &lifecycle.Options{WaitTimeout: 50 * time.Millisecond, RetryBackoff: 3 * time.Second, RetryMaxBackoff: 3 * time.Second}, context.Background())
+	defer func() { functionLifecycle = initializedLifecycle() }()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/1", nil)
+	rec := httptest.NewRecorder()
+
+	serve("GET /not-registered", rec, req)
+
+	assert.EqualValues(t, http.StatusServiceUnavailable, rec.Result().StatusCode)
+	assert.Contains(t, []string{"1", "2", "3"}, rec.Result().Header.Get("Retry-After"))
+	assert.EqualValues(t, "Service not ready: the function failed to initialize: database is not available", rec.Body.String())
+}
+
+func TestServiceShuttingDown(t *testing.T) {
+	functionShutdown = lifecycle.NewShutdown()
+	functionShutdown.Shutdown(time.Second)
+	defer func() { functionShutdown = lifecycle.NewShutdown() }()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/1", nil)
+	rec := httptest.NewRecorder()
+
+	Handle(rec, req)
+
+	assert.EqualValues(t, http.StatusServiceUnavailable, rec.Result().StatusCode)
+	assert.EqualValues(t, "close", rec.Result().Header.Get("Connection"))
+	assert.EqualValues(t, "Service shutting down", rec.Body.String())
+}
+
+func TestMetricsByRoute(t *testing.T) {
+	Handle(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/starwar/planets/7", nil))
+	Handle(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/wp-login.php", nil))
+
+	rec := httptest.NewRecorder()
+	Handle(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.EqualValues(t, http.StatusOK, rec.Result().StatusCode)
+	assert.Contains(t, rec.Body.String(), `http_requests_total{method="GET",route="GET /planets/",status="200"}`)
+	assert.Contains(t, rec.Body.String(), `http_requests_total{method="GET",route="unknown",status="404"}`)
+	assert.NotContains(t, rec.Body.String(), "/api/v1/starwar/planets/7")
+}
+
+func TestTraceparentPropagation(t *testing.T) {
+	var spanContext trace.SpanContext
+	routes["GET /characters/"] = func(w http.ResponseWriter, r *http.Request) {
+		spanContext = trace.SpanContextFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}
+	defer func() { routes["GET /characters/"] = mockFindCharacter }()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/1", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+
+	Handle(rec, req)
+
+	assert.EqualValues(t, http.StatusOK, rec.Result().StatusCode)
+	assert.EqualValues(t, "4bf92f3577b34da6a3ce929d0e0e4736", spanContext.TraceID().String())
+	assert.True(t, spanContext.IsSampled())
+}
@@ -0,0 +1,189 @@
+package chache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	cacheModel "handler/function/internal/adapter/chache/model"
+	model "handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var _ out.CharacterStatsCache = (*CharacterStatsRedisAdapter)(nil)
+
+// CharacterStatsRedisAdapter takes its own options, statistics live longer than single characters.
+type CharacterStatsRedisAdapter struct {
+	client       *redis.Client
+	cacheOptions *cacheModel.CacheOptions
+}
+
+func NewCharacterStatsRedisAdapter(c *redis.Client, o *cacheModel.CacheOptions) (*CharacterStatsRedisAdapter, error) {
+	return &CharacterStatsRedisAdapter{client: c, cacheOptions: o}, nil
+}
+
+// characterStatsKey hashes the filters of the query, the same filters written in another
+// order of parameters share the entry while sort and page are left out.
+func characterStatsKey(query *model.CharacterQuery) string {
+	key := strings.Builder{}
+	writeRangeKey(&key, "height", query.Height)
+	writeRangeKey(&key, "mass", query.Mass)
+	writeRangeKey(&key, "birth_year", query.BirthYear)
+
+	if query.Filter != nil {
+		key.WriteString("filter:")
+		writeFilterKey(&key, query.Filter)
+	}
+
+	hash := sha256.Sum256([]byte(key.String()))
+	return "stats:" + hex.EncodeToString(hash[:])
+}
+
+func writeRangeKey(key *strings.Builder, field string, rangeFilter model.RangeFilter) {
+	if rangeFilter.Min != nil {
+		key.WriteString(fmt.Sprintf("%s>=%s;", field, strconv.FormatFloat(*rangeFilter.Min, 'g', -1, 64)))
+	}
+	if rangeFilter.Max != nil {
+		key.WriteString(fmt.Sprintf("%s<=%s;", field, strconv.FormatFloat(*rangeFilter.Max, 'g', -1, 64)))
+	}
+}
+
+func writeFilterKey(key *strings.Builder, expression model.FilterExpression) {
+	switch e := expression.(type) {
+	case model.FilterLogical:
+		key.WriteString("(")
+		writeFilterKey(key, e.Left)
+		key.WriteString(" " + string(e.Operator) + " ")
+		writeFilterKey(key, e.Right)
+		key.WriteString(")")
+
+	case model.FilterNot:
+		key.WriteString("not ")
+		writeFilterKey(key, e.Expression)
+
+	case model.FilterComparison:
+		key.WriteString(e.Field + " " + string(e.Operator) + " ")
+		switch value := e.Value.(type) {
+		case string:
+			key.WriteString(strconv.Quote(value))
+		case float64:
+			key.WriteString(strconv.FormatFloat(value, 'g', -1, 64))
+		default:
+			key.WriteString("null")
+		}
+	}
+}
+
+func (s CharacterStatsRedisAdapter) SaveCharacterStats(query *model.CharacterQuery, stats *model.CharacterStats, ctx context.Context) error {
+
+	key := characterStatsKey(query)
+	log.Printf("Storing character statistics in redis cache %s\n", key)
+
+	statsJson, errJson := json.Marshal(&cacheModel.CharacterStatsCache{
+		Total:       stats.Total,
+		ByGender:    groupCountsToCache(stats.ByGender),
+		ByEyeColor:  groupCountsToCache(stats.ByEyeColor),
+		ByHomeworld: groupCountsToCache(stats.ByHomeworld),
+		Height:      cacheModel.MeasureStatsCache(stats.Height),
+		Mass:        cacheModel.MeasureStatsCache(stats.Mass),
+	})
+
+	if errJson != nil {
+		log.Printf("Error parsing struc to json character statistics %s\n", errJson.Error())
+		s.observe("save", cacheModel.CacheError)
+		return pkg.GenericException{
+			Msj:        fmt.Sprintf("error accessing to cache: %s\n", errJson.Error()),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	_, err := s.client.Set(ctx, key, statsJson, s.cacheOptions.Ttl).Result()
+
+	if err != nil {
+		log.Printf("error storing in redis cache: %s\n", err.Error())
+		s.observe("save", cacheModel.CacheError)
+		return pkg.GenericException{
+			Msj:        fmt.Sprintf("error accessing to cache: %s", err.Error()),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	s.observe("save", cacheModel.CacheOk)
+	return nil
+}
+
+func (s CharacterStatsRedisAdapter) FindCharacterStats(query *model.CharacterQuery, ctx context.Context) (*model.CharacterStats, error) {
+
+	key := characterStatsKey(query)
+	log.Printf("Searching character statistics in redis: %s\n", key)
+
+	val, err := s.client.Get(ctx, key).Result()
+
+	switch {
+	case err == redis.Nil:
+		log.Printf("key %s does not exist\n", key)
+		s.observe("find", cacheModel.CacheMiss)
+		return nil, nil
+
+	case err != nil:
+		log.Println("Get failed", err)
+		s.observe("find", cacheModel.CacheError)
+		return nil, pkg.GenericException{
+			Msj:        fmt.Sprintf("error accessing to cache: %s\n", err.Error()),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	jsonStatsModel := &cacheModel.CharacterStatsCache{}
+
+	parsingJsonError := json.Unmarshal([]byte(val), jsonStatsModel)
+
+	if parsingJsonError != nil {
+		log.Printf("Error parsing json response from redis: %s\n", parsingJsonError.Error())
+		s.observe("find", cacheModel.CacheError)
+		return nil, pkg.GenericException{
+			Msj:        fmt.Sprintf("error parsing json response from redis: %s\n", parsingJsonError.Error()),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	s.observe("find", cacheModel.CacheHit)
+	return &model.CharacterStats{
+		Total:       jsonStatsModel.Total,
+		ByGender:    groupCountsFromCache(jsonStatsModel.ByGender),
+		ByEyeColor:  groupCountsFromCache(jsonStatsModel.ByEyeColor),
+		ByHomeworld: groupCountsFromCache(jsonStatsModel.ByHomeworld),
+		Height:      model.MeasureStats(jsonStatsModel.Height),
+		Mass:        model.MeasureStats(jsonStatsModel.Mass),
+	}, nil
+}
+
+// observe records the result of a request to the statistics cache, when there is an observer.
+func (s CharacterStatsRedisAdapter) observe(operation string, result string) {
+	if s.cacheOptions.Observer != nil {
+		s.cacheOptions.Observer.ObserveCache("stats", operation, result)
+	}
+}
+
+func groupCountsToCache(groupCounts []model.GroupCount) []cacheModel.GroupCountCache {
+	cached := make([]cacheModel.GroupCountCache, 0, len(groupCounts))
+	for _, groupCount := range groupCounts {
+		cached = append(cached, cacheModel.GroupCountCache(groupCount))
+	}
+	return cached
+}
+
+func groupCountsFromCache(cached []cacheModel.GroupCountCache) []model.GroupCount {
+	groupCounts := make([]model.GroupCount, 0, len(cached))
+	for _, groupCount := range cached {
+		groupCounts = append(groupCounts, model.GroupCount(groupCount))
+	}
+	return groupCounts
+}
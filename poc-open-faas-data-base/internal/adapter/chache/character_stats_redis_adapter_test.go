@@ -0,0 +1,163 @@
+package chache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	cacheModel "handler/function/internal/adapter/chache/model"
+	"handler/function/internal/application/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var statsCacheOptions = cacheModel.CacheOptions{Ttl: 5 * time.Minute}
+
+var CharacterStats = model.CharacterStats{
+	Total:       1,
+	ByGender:    []model.GroupCount{{Value: "male", Count: 1}},
+	ByEyeColor:  []model.GroupCount{{Value: "yellow", Count: 1}},
+	ByHomeworld: []model.GroupCount{{Value: "https://swapi.dev/api/planets/1/", Count: 1}},
+	Height:      model.MeasureStats{Min: &characterHeight, Max: &characterHeight, Avg: &characterHeight, Unit: model.HeightUnit, Samples: 1},
+	Mass:        model.MeasureStats{Unit: model.MassUnit},
+}
+
+func statsQuery(t *testing.T, filter string) *model.CharacterQuery {
+	expression, err := model.ParseFilter(filter)
+	assert.Nil(t, err)
+
+	return &model.CharacterQuery{Filter: expression}
+}
+
+func TestCharacterStatsKey(t *testing.T) {
+
+	minHeight := 150.0
+
+	key := characterStatsKey(statsQuery(t, `gender eq "female" and height gt 170`))
+
+	assert.Regexp(t, "^stats:[0-9a-f]{64}$", key)
+	assert.Equal(t, key, characterStatsKey(statsQuery(t, `gender  EQ "female" and (height gt 170.0)`)))
+	assert.NotEqual(t, key, characterStatsKey(statsQuery(t, `gender eq "female" or height gt 170`)))
+	assert.NotEqual(t, key, characterStatsKey(&model.CharacterQuery{Height: model.RangeFilter{Min: &minHeight}}))
+
+	withPage := statsQuery(t, `gender eq "female" and height gt 170`)
+	withPage.Limit, withPage.Offset = 10, 20
+	withPage.Sort = []model.SortOrder{{Field: "name"}}
+	assert.Equal(t, key, characterStatsKey(withPage))
+}
+
+func TestCharacterStatsRedisAdapter_SaveCharacterStats(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/stats", nil)
+	ctx := req.Context()
+	query := &model.CharacterQuery{}
+
+	redisCliMock, mock := redismock.NewClientMock()
+	statsJson, _ := json.Marshal(&cacheModel.CharacterStatsCache{
+		Total:       1,
+		ByGender:    []cacheModel.GroupCountCache{{Value: "male", Count: 1}},
+		ByEyeColor:  []cacheModel.GroupCountCache{{Value: "yellow", Count: 1}},
+		ByHomeworld: []cacheModel.GroupCountCache{{Value: "https://swapi.dev/api/planets/1/", Count: 1}},
+		Height:      cacheModel.MeasureStatsCache{Min: &characterHeight, Max: &characterHeight, Avg: &characterHeight, Unit: model.HeightUnit, Samples: 1},
+		Mass:        cacheModel.MeasureStatsCache{Unit: model.MassUnit},
+	})
+	mock.ExpectSet(characterStatsKey(query), statsJson, statsCacheOptions.Ttl).SetVal("OK")
+
+	adapter, _ := NewCharacterStatsRedisAdapter(redisCliMock, &statsCacheOptions)
+
+	err := adapter.SaveCharacterStats(query, &CharacterStats, ctx)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCharacterStatsRedisAdapter_FindCharacterStats(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/stats", nil)
+	ctx := req.Context()
+	query := statsQuery(t, `eye_color eq "yellow"`)
+
+	redisCliMock, mock := redismock.NewClientMock()
+	statsJson, _ := json.Marshal(&cacheModel.CharacterStatsCache{
+		Total:       1,
+		ByGender:    []cacheModel.GroupCountCache{{Value: "male", Count: 1}},
+		ByEyeColor:  []cacheModel.GroupCountCache{{Value: "yellow", Count: 1}},
+		ByHomeworld: []cacheModel.GroupCountCache{{Value: "https://swapi.dev/api/planets/1/", Count: 1}},
+		Height:      cacheModel.MeasureStatsCache{Min: &characterHeight, Max: &characterHeight, Avg: &characterHeight, Unit: model.HeightUnit, Samples: 1},
+		Mass:        cacheModel.MeasureStatsCache{Unit: model.MassUnit},
+	})
+	mock.ExpectGet(characterStatsKey(query)).SetVal(string(statsJson))
+
+	adapter, _ := NewCharacterStatsRedisAdapter(redisCliMock, &statsCacheOptions)
+
+	stats, err := adapter.FindCharacterStats(query, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &CharacterStats, stats)
+}
+
+func TestCharacterStatsRedisAdapter_FindCharacterStatsNotFound(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/stats", nil)
+	ctx := req.Context()
+	query := &model.CharacterQuery{}
+
+	redisCliMock, mock := redismock.NewClientMock()
+	mock.ExpectGet(characterStatsKey(query)).RedisNil()
+
+	adapter, _ := NewCharacterStatsRedisAdapter(redisCliMock, &statsCacheOptions)
+
+	stats, err := adapter.FindCharacterStats(query, ctx)
+
+	assert.Nil(t, err)
+	assert.Nil(t, stats)
+}
+
+func TestCharacterStatsRedisAdapter_ObservesResults(t *testing.T) {
+
+	type testCase struct {
+		name      string
+		expect    func(mock redismock.ClientMock, key string)
+		call      func(adapter *CharacterStatsRedisAdapter) error
+		operation string
+		result    string
+	}
+
+	query := &model.CharacterQuery{}
+
+	find := func(adapter *CharacterStatsRedisAdapter) error {
+		_, err := adapter.FindCharacterStats(query, context.Background())
+		return err
+	}
+
+	save := func(adapter *CharacterStatsRedisAdapter) error {
+		return adapter.SaveCharacterStats(query, &CharacterStats, context.Background())
+	}
+
+	testCases := []testCase{
+		{name: "hit", expect: func(mock redismock.ClientMock, key string) { mock.ExpectGet(key).SetVal("{}") },
+			call: find, operation: "find", result: cacheModel.CacheHit},
+		{name: "miss", expect: func(mock redismock.ClientMock, key string) { mock.ExpectGet(key).RedisNil() },
+			call: find, operation: "find", result: cacheModel.CacheMiss},
+		{name: "find error", expect: func(mock redismock.ClientMock, key string) { mock.ExpectGet(key).SetErr(fmt.Errorf("generic Error")) },
+			call: find, operation: "find", result: cacheModel.CacheError},
+		{name: "save error", expect: func(mock redismock.ClientMock, key string) {}, call: save, operation: "save", result: cacheModel.CacheError},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			redisCliMock, redisMock := redismock.NewClientMock()
+			test.expect(redisMock, characterStatsKey(query))
+
+			observer := CacheObserverMock{}
+			observer.On("ObserveCache", "stats", test.operation, test.result).Return()
+
+			adapter, _ := NewCharacterStatsRedisAdapter(redisCliMock, &cacheModel.CacheOptions{Ttl: statsCacheOptions.Ttl, Observer: &observer})
+
+			test.call(adapter)
+
+			observer.AssertExpectations(t)
+			observer.AssertNumberOfCalls(t, "ObserveCache", 1)
+		})
+	}
+}
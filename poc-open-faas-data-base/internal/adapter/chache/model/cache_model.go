@@ -4,20 +4,85 @@ import "time"
 
 type CacheOptions struct {
 	Ttl time.Duration
+	// Observer records the result of every cache request, it is optional.
+	Observer CacheObserver
+}
+
+// Cache results, a read is a hit or a miss and a write is ok, both can fail with an error.
+const (
+	CacheHit   = "hit"
+	CacheMiss  = "miss"
+	CacheOk    = "ok"
+	CacheError = "error"
+)
+
+type CacheObserver interface {
+	ObserveCache(cache string, operation string, result string)
 }
 
 type CharacterCache struct {
-	Id        int    `json:"Id"`
-	Name      string `json:"name"`
-	Height    string `json:"height"`
-	Mass      string `json:"mass"`
-	HairColor string `json:"hair_color"`
-	SkinColor string `json:"skin_color"`
-	EyeColor  string `json:"eye_color"`
-	BirthYear string `json:"birth_year"`
-	Gender    string `json:"gender"`
-	Homeworld string `json:"homeworld"`
-	Created   string `json:"created"`
-	Edited    string `json:"edited"`
-	Url       string `json:"url"`
+	Id             int         `json:"Id"`
+	Name           string      `json:"name"`
+	Height         string      `json:"height"`
+	Mass           string      `json:"mass"`
+	HairColor      string      `json:"hair_color"`
+	SkinColor      string      `json:"skin_color"`
+	EyeColor       string      `json:"eye_color"`
+	BirthYear      string      `json:"birth_year"`
+	BirthYearValue *float64    `json:"birth_year_value,omitempty"`
+	Gender         string      `json:"gender"`
+	Homeworld      string      `json:"homeworld"`
+	HomeworldId    *int        `json:"homeworld_id,omitempty"`
+	Created        time.Time   `json:"created"`
+	Edited         time.Time   `json:"edited"`
+	Url            string      `json:"url"`
+	Films          []LinkCache `json:"films,omitempty"`
+	Species        []LinkCache `json:"species,omitempty"`
+	Vehicles       []LinkCache `json:"vehicles,omitempty"`
+	Starships      []LinkCache `json:"starships,omitempty"`
+	Version        int         `json:"version"`
+}
+
+type LinkCache struct {
+	Id  int    `json:"id"`
+	Url string `json:"url"`
+}
+
+type PlanetCache struct {
+	Id             int       `json:"Id"`
+	Name           string    `json:"name"`
+	RotationPeriod string    `json:"rotation_period"`
+	OrbitalPeriod  string    `json:"orbital_period"`
+	Diameter       string    `json:"diameter"`
+	Climate        string    `json:"climate"`
+	Gravity        string    `json:"gravity"`
+	Terrain        string    `json:"terrain"`
+	SurfaceWater   string    `json:"surface_water"`
+	Population     string    `json:"population"`
+	Created        time.Time `json:"created"`
+	Edited         time.Time `json:"edited"`
+	Url            string    `json:"url"`
+	Version        int       `json:"version"`
+}
+
+type CharacterStatsCache struct {
+	Total       int               `json:"total"`
+	ByGender    []GroupCountCache `json:"by_gender"`
+	ByEyeColor  []GroupCountCache `json:"by_eye_color"`
+	ByHomeworld []GroupCountCache `json:"by_homeworld"`
+	Height      MeasureStatsCache `json:"height"`
+	Mass        MeasureStatsCache `json:"mass"`
+}
+
+type GroupCountCache struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type MeasureStatsCache struct {
+	Min     *float64 `json:"min,omitempty"`
+	Max     *float64 `json:"max,omitempty"`
+	Avg     *float64 `json:"avg,omitempty"`
+	Unit    string   `json:"unit"`
+	Samples int      `json:"samples"`
 }
@@ -0,0 +1,145 @@
+package chache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	cacheModel "handler/function/internal/adapter/chache/model"
+	model "handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+)
+
+var _ out.PlanetCache = (*PlanetRedisAdapter)(nil)
+
+type PlanetRedisAdapter struct {
+	client       *redis.Client
+	cacheOptions *cacheModel.CacheOptions
+}
+
+func NewPlanetRedisAdapter(c *redis.Client, o *cacheModel.CacheOptions) (*PlanetRedisAdapter, error) {
+	return &PlanetRedisAdapter{client: c, cacheOptions: o}, nil
+}
+
+// planetKey is prefixed, character keys are the bare id.
+func planetKey(id int) string {
+	return fmt.Sprintf("planet:%d", id)
+}
+
+func (s PlanetRedisAdapter) SavePlanet(planet *model.PlanetDetail, ctx context.Context) error {
+
+	key := planetKey(planet.Id.Id)
+	log.Printf("Storing in redis cache %+v\n", planet)
+
+	jsonPlanetModel := &cacheModel.PlanetCache{
+		Id:             planet.Id.Id,
+		Name:           planet.Planet.Name,
+		RotationPeriod: planet.Planet.RotationPeriod,
+		OrbitalPeriod:  planet.Planet.OrbitalPeriod,
+		Diameter:       planet.Planet.Diameter,
+		Climate:        planet.Planet.Climate,
+		Gravity:        planet.Planet.Gravity,
+		Terrain:        planet.Planet.Terrain,
+		SurfaceWater:   planet.Planet.SurfaceWater,
+		Population:     planet.Planet.Population,
+		Created:        planet.Planet.Created,
+		Edited:         planet.Planet.Edited,
+		Url:            planet.Planet.Url,
+		Version:        planet.Version,
+	}
+
+	planetJson, errJson := json.Marshal(jsonPlanetModel)
+
+	if errJson != nil {
+		log.Printf("Error parsing struc to json planet %s\n", errJson.Error())
+		return pkg.GenericException{
+			Msj:        fmt.Sprintf("error accessing to cache: %s\n", errJson.Error()),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	_, err := s.client.Set(ctx, key, planetJson, s.cacheOptions.Ttl).Result()
+
+	if err != nil {
+		log.Printf("error storing in redis cache: %s\n", err.Error())
+		return pkg.GenericException{
+			Msj:        fmt.Sprintf("error accessing to cache: %s", err.Error()),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+func (s PlanetRedisAdapter) FindPlanetById(planet *model.PlanetIdentifier, ctx context.Context) (*model.PlanetDetail, error) {
+
+	log.Printf("Searching planet in redis by id: %d\n", planet.Id)
+
+	key := planetKey(planet.Id)
+
+	val, err := s.client.Get(ctx, key).Result()
+
+	switch {
+	case err == redis.Nil:
+		log.Printf("key %s does not exist\n", key)
+		return nil, nil
+
+	case err != nil:
+		log.Println("Get failed", err)
+		return nil, pkg.GenericException{
+			Msj:        fmt.Sprintf("error accessing to cache: %s\n", err.Error()),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	jsonPlanetModel := &cacheModel.PlanetCache{}
+
+	parsingJsonError := json.Unmarshal([]byte(val), jsonPlanetModel)
+
+	if parsingJsonError != nil {
+		log.Printf("Error parsing json response from redis: %s\n", parsingJsonError.Error())
+		return nil, pkg.GenericException{
+			Msj:        fmt.Sprintf("error parsing json response from redis: %s\n", parsingJsonError.Error()),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	return &model.PlanetDetail{
+		Id: &model.PlanetIdentifier{Id: jsonPlanetModel.Id},
+		Planet: &model.Planet{
+			Name:           jsonPlanetModel.Name,
+			RotationPeriod: jsonPlanetModel.RotationPeriod,
+			OrbitalPeriod:  jsonPlanetModel.OrbitalPeriod,
+			Diameter:       jsonPlanetModel.Diameter,
+			Climate:        jsonPlanetModel.Climate,
+			Gravity:        jsonPlanetModel.Gravity,
+			Terrain:        jsonPlanetModel.Terrain,
+			SurfaceWater:   jsonPlanetModel.SurfaceWater,
+			Population:     jsonPlanetModel.Population,
+			Created:        jsonPlanetModel.Created,
+			Edited:         jsonPlanetModel.Edited,
+			Url:            jsonPlanetModel.Url,
+		},
+		Version: jsonPlanetModel.Version,
+	}, nil
+}
+
+func (s PlanetRedisAdapter) DeletePlanet(planet *model.PlanetIdentifier, ctx context.Context) error {
+
+	log.Printf("Removing planet from redis by id: %d\n", planet.Id)
+
+	_, err := s.client.Del(ctx, planetKey(planet.Id)).Result()
+
+	if err != nil {
+		log.Printf("error removing from redis cache: %s\n", err.Error())
+		return pkg.GenericException{
+			Msj:        fmt.Sprintf("error accessing to cache: %s\n", err.Error()),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
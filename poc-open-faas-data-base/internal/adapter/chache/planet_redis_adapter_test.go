@@ -0,0 +1,136 @@
+package chache
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	cacheModel "handler/function/internal/adapter/chache/model"
+	"handler/function/internal/application/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var planet = model.Planet{
+	Name:           "Tatooine",
+	RotationPeriod: "23",
+	OrbitalPeriod:  "304",
+	Diameter:       "10465",
+	Climate:        "arid",
+	Gravity:        "1 standard",
+	Terrain:        "desert",
+	SurfaceWater:   "1",
+	Population:     "200000",
+	Created:        time.Date(2014, 12, 9, 13, 50, 49, 641000000, time.UTC),
+	Edited:         time.Date(2014, 12, 20, 20, 58, 18, 411000000, time.UTC),
+	Url:            "https://swapi.dev/api/planets/1/",
+}
+
+var PlanetIdentifier = model.PlanetIdentifier{
+	Id: 1,
+}
+var PlanetDetail = model.PlanetDetail{
+	Planet:  &planet,
+	Id:      &PlanetIdentifier,
+	Version: 1,
+}
+
+var PlanetCacheModel = &cacheModel.PlanetCache{
+	Id:             PlanetDetail.Id.Id,
+	Name:           PlanetDetail.Planet.Name,
+	RotationPeriod: PlanetDetail.Planet.RotationPeriod,
+	OrbitalPeriod:  PlanetDetail.Planet.OrbitalPeriod,
+	Diameter:       PlanetDetail.Planet.Diameter,
+	Climate:        PlanetDetail.Planet.Climate,
+	Gravity:        PlanetDetail.Planet.Gravity,
+	Terrain:        PlanetDetail.Planet.Terrain,
+	SurfaceWater:   PlanetDetail.Planet.SurfaceWater,
+	Population:     PlanetDetail.Planet.Population,
+	Created:        PlanetDetail.Planet.Created,
+	Edited:         PlanetDetail.Planet.Edited,
+	Url:            PlanetDetail.Planet.Url,
+	Version:        PlanetDetail.Version,
+}
+
+func TestPlanetRedisAdapter_SavePlanet(t *testing.T) {
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	redisCliMock, mock := redismock.NewClientMock()
+	planetJson, _ := json.Marshal(PlanetCacheModel)
+	mock.ExpectSet("planet:1", planetJson, cacheOptions.Ttl).SetVal("1")
+
+	adapter, _ := NewPlanetRedisAdapter(redisCliMock, &cacheOptions)
+
+	err := adapter.SavePlanet(&PlanetDetail, ctx)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestPlanetRedisAdapter_FindPlanetById(t *testing.T) {
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	redisCliMock, mock := redismock.NewClientMock()
+	planetJson, _ := json.Marshal(PlanetCacheModel)
+	mock.ExpectGet("planet:1").SetVal(string(planetJson))
+
+	adapter, _ := NewPlanetRedisAdapter(redisCliMock, &cacheOptions)
+
+	planetDetail, err := adapter.FindPlanetById(&PlanetIdentifier, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &PlanetDetail, planetDetail)
+}
+
+func TestPlanetRedisAdapter_FindPlanetByIdNotFound(t *testing.T) {
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	redisCliMock, mock := redismock.NewClientMock()
+	mock.ExpectGet("planet:1").RedisNil()
+
+	adapter, _ := NewPlanetRedisAdapter(redisCliMock, &cacheOptions)
+
+	planetDetail, err := adapter.FindPlanetById(&PlanetIdentifier, ctx)
+
+	assert.Nil(t, err)
+	assert.Nil(t, planetDetail)
+}
+
+func TestPlanetRedisAdapter_DeletePlanetError(t *testing.T) {
+	req := httptest.NewRequest(
+		http.MethodDelete,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	redisCliMock, mock := redismock.NewClientMock()
+	mock.ExpectDel("planet:1").SetErr(fmt.Errorf("generic Error"))
+
+	adapter, _ := NewPlanetRedisAdapter(redisCliMock, &cacheOptions)
+
+	err := adapter.DeletePlanet(&PlanetIdentifier, ctx)
+
+	assert.NotNil(t, err)
+}
@@ -15,6 +15,7 @@ import (
 )
 
 var _ out.StarwarCache = (*StarwarRedisAdapter)(nil)
+var _ out.DependencyPinger = (*StarwarRedisAdapter)(nil)
 
 type StarwarRedisAdapter struct {
 	client       *redis.Client
@@ -31,25 +32,33 @@ func (s StarwarRedisAdapter) SaveCharacter(character *model.CharacterDetail, ctx
 	log.Printf("Storing in redis cache %+v\n", character)
 
 	jsonCharacterModel := &cacheModel.CharacterCache{
-		Id:        character.Id.Id,
-		Name:      character.Character.Name,
-		Height:    character.Character.Height,
-		Mass:      character.Character.Mass,
-		HairColor: character.Character.HairColor,
-		SkinColor: character.Character.SkinColor,
-		EyeColor:  character.Character.EyeColor,
-		BirthYear: character.Character.BirthYear,
-		Gender:    character.Character.Gender,
-		Homeworld: character.Character.Homeworld,
-		Created:   character.Character.Created,
-		Edited:    character.Character.Edited,
-		Url:       character.Character.Url,
+		Id:             character.Id.Id,
+		Name:           character.Character.Name,
+		Height:         character.Character.Height.String(),
+		Mass:           character.Character.Mass.String(),
+		HairColor:      character.Character.HairColor,
+		SkinColor:      character.Character.SkinColor,
+		EyeColor:       character.Character.EyeColor,
+		BirthYear:      character.Character.BirthYear.String(),
+		BirthYearValue: character.Character.BirthYear.Year,
+		Gender:         character.Character.Gender,
+		Homeworld:      character.Character.Homeworld,
+		HomeworldId:    character.Character.HomeworldId,
+		Created:        character.Character.Created,
+		Edited:         character.Character.Edited,
+		Url:            character.Character.Url,
+		Films:          linksToCache(character.Character.Films),
+		Species:        linksToCache(character.Character.Species),
+		Vehicles:       linksToCache(character.Character.Vehicles),
+		Starships:      linksToCache(character.Character.Starships),
+		Version:        character.Version,
 	}
 
 	characterJson, errJson := json.Marshal(jsonCharacterModel)
 
 	if errJson != nil {
 		log.Printf("Error parsing struc to json character %s\n", errJson.Error())
+		s.observe("save", cacheModel.CacheError)
 		return pkg.GenericException{
 			Msj:        fmt.Sprintf("error accessing to cache: %s\n", errJson.Error()),
 			StatusCode: http.StatusInternalServerError,
@@ -60,6 +69,7 @@ func (s StarwarRedisAdapter) SaveCharacter(character *model.CharacterDetail, ctx
 
 	if err != nil {
 		log.Printf("error storing in redis cache: %s\n", err.Error())
+		s.observe("save", cacheModel.CacheError)
 		return &pkg.GenericException{
 			Msj:        fmt.Sprintf("error accessing to cache: %s", err.Error()),
 			StatusCode: http.StatusInternalServerError,
@@ -67,6 +77,7 @@ func (s StarwarRedisAdapter) SaveCharacter(character *model.CharacterDetail, ctx
 	}
 
 	log.Printf("Value from redis: %s\n", val)
+	s.observe("save", cacheModel.CacheOk)
 
 	return nil
 }
@@ -82,10 +93,12 @@ func (s StarwarRedisAdapter) FindCharacterById(character *model.CharacterIdentif
 	switch {
 	case err == redis.Nil:
 		fmt.Printf("key %s does not exist\n", key)
+		s.observe("find", cacheModel.CacheMiss)
 		return nil, nil
 
 	case err != nil:
 		fmt.Println("Get failed", err)
+		s.observe("find", cacheModel.CacheError)
 		return nil, pkg.GenericException{
 			Msj:        fmt.Sprintf("error accessing to cache: %s\n", err.Error()),
 			StatusCode: http.StatusInternalServerError,
@@ -97,27 +110,108 @@ func (s StarwarRedisAdapter) FindCharacterById(character *model.CharacterIdentif
 
 	if parsingJsonError != nil {
 		log.Printf("Error parsing json response from redis: %s\n", parsingJsonError.Error())
+		s.observe("find", cacheModel.CacheError)
 		return nil, pkg.GenericException{
 			Msj:        fmt.Sprintf("error parsing json response from redis: %s\n", parsingJsonError.Error()),
 			StatusCode: http.StatusInternalServerError,
 		}
 	}
 
+	height, heightErr := model.ParseHeight(jsonCharacterModel.Height)
+	mass, massErr := model.ParseMass(jsonCharacterModel.Mass)
+
+	if heightErr != nil || massErr != nil {
+		log.Printf("Error parsing measures from redis: %v %v\n", heightErr, massErr)
+		s.observe("find", cacheModel.CacheError)
+		return nil, pkg.GenericException{
+			Msj:        fmt.Sprintf("error parsing measures from redis: %s %s\n", jsonCharacterModel.Height, jsonCharacterModel.Mass),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	s.observe("find", cacheModel.CacheHit)
+
 	return &model.CharacterDetail{
 		Id: &model.CharacterIdentifier{Id: jsonCharacterModel.Id},
 		Character: &model.Character{
-			Name:      jsonCharacterModel.Name,
-			Height:    jsonCharacterModel.Height,
-			Mass:      jsonCharacterModel.Mass,
-			HairColor: jsonCharacterModel.HairColor,
-			SkinColor: jsonCharacterModel.SkinColor,
-			EyeColor:  jsonCharacterModel.EyeColor,
-			BirthYear: jsonCharacterModel.BirthYear,
-			Gender:    jsonCharacterModel.Gender,
-			Homeworld: jsonCharacterModel.Homeworld,
-			Created:   jsonCharacterModel.Created,
-			Edited:    jsonCharacterModel.Edited,
-			Url:       jsonCharacterModel.Url,
+			Name:        jsonCharacterModel.Name,
+			Height:      height,
+			Mass:        mass,
+			HairColor:   jsonCharacterModel.HairColor,
+			SkinColor:   jsonCharacterModel.SkinColor,
+			EyeColor:    jsonCharacterModel.EyeColor,
+			BirthYear:   model.NewBirthYear(jsonCharacterModel.BirthYearValue, jsonCharacterModel.BirthYear),
+			Gender:      jsonCharacterModel.Gender,
+			Homeworld:   jsonCharacterModel.Homeworld,
+			HomeworldId: jsonCharacterModel.HomeworldId,
+			Created:     jsonCharacterModel.Created,
+			Edited:      jsonCharacterModel.Edited,
+			Url:         jsonCharacterModel.Url,
+			Films:       linksFromCache(jsonCharacterModel.Films),
+			Species:     linksFromCache(jsonCharacterModel.Species),
+			Vehicles:    linksFromCache(jsonCharacterModel.Vehicles),
+			Starships:   linksFromCache(jsonCharacterModel.Starships),
 		},
+		Version: jsonCharacterModel.Version,
 	}, nil
 }
+
+func (s StarwarRedisAdapter) DeleteCharacter(character *model.CharacterIdentifier, ctx context.Context) error {
+
+	log.Printf("Removing value from redis by id: %d\n", character.Id)
+
+	key := strconv.Itoa(character.Id)
+
+	_, err := s.client.Del(ctx, key).Result()
+
+	if err != nil {
+		log.Printf("error removing from redis cache: %s\n", err.Error())
+		s.observe("delete", cacheModel.CacheError)
+		return pkg.GenericException{
+			Msj:        fmt.Sprintf("error accessing to cache: %s\n", err.Error()),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	s.observe("delete", cacheModel.CacheOk)
+
+	return nil
+}
+
+// observe records the result of a request to the character cache, when there is an observer.
+func (s StarwarRedisAdapter) observe(operation string, result string) {
+	if s.cacheOptions.Observer != nil {
+		s.cacheOptions.Observer.ObserveCache("character", operation, result)
+	}
+}
+
+// Ping checks the redis server answers, the readiness probe calls it.
+func (s StarwarRedisAdapter) Ping(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
+
+func linksToCache(links []model.ResourceLink) []cacheModel.LinkCache {
+	if len(links) == 0 {
+		return nil
+	}
+
+	cacheLinks := make([]cacheModel.LinkCache, 0, len(links))
+	for _, link := range links {
+		cacheLinks = append(cacheLinks, cacheModel.LinkCache{Id: link.Id, Url: link.Url})
+	}
+
+	return cacheLinks
+}
+
+func linksFromCache(cacheLinks []cacheModel.LinkCache) []model.ResourceLink {
+	if len(cacheLinks) == 0 {
+		return nil
+	}
+
+	links := make([]model.ResourceLink, 0, len(cacheLinks))
+	for _, cacheLink := range cacheLinks {
+		links = append(links, model.ResourceLink{Id: cacheLink.Id, Url: cacheLink.Url})
+	}
+
+	return links
+}
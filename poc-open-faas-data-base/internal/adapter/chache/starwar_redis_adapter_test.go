@@ -1,10 +1,12 @@
 package chache
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/go-redis/redismock/v9"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	cacheModel "handler/function/internal/adapter/chache/model"
 	"handler/function/internal/application/model"
 	"net/http"
@@ -14,22 +16,26 @@ import (
 	"time"
 )
 
+var characterHeight = 202.0
+var characterMass = 136.0
+var characterBirthYear = -41.9
+
 var character = model.Character{
 	Name:      "Darth Ezequiel",
-	Height:    "202",
-	Mass:      "136",
+	Height:    model.NewMeasure(&characterHeight, model.HeightUnit),
+	Mass:      model.NewMeasure(&characterMass, model.MassUnit),
 	HairColor: "none",
 	SkinColor: "white",
 	EyeColor:  "yellow",
-	BirthYear: "41.9BBY",
+	BirthYear: model.NewBirthYear(&characterBirthYear, "41.9BBY"),
 	Gender:    "male",
 	Homeworld: "https://swapi.dev/api/planets/1/",
-	Created:   "2014-12-10T15:18:20.704000Z",
-	Edited:    "2014-12-20T21:17:50.313000Z",
+	Created:   time.Date(2014, 12, 10, 15, 18, 20, 704000000, time.UTC),
+	Edited:    time.Date(2014, 12, 20, 21, 17, 50, 313000000, time.UTC),
 	Url:       "https://swapi.dev/api/people/4/",
 }
 
-var jsonCharacter = "{\n\"Id\": 1,\n\"name\": \"Darth Ezequiel\",\n\"height\": \"202\",\n\"mass\": \"136\",\n\"hair_color\": \"none\",\n\"skin_color\": \"white\",\n\"eye_color\": \"yellow\",\n\"birth_year\": \"41.9BBY\",\n\"gender\": \"male\",\n\"homeworld\": \"https://swapi.dev/api/planets/1/\",\n\"created\": \"2014-12-10T15:18:20.704000Z\",\n\"edited\": \"2014-12-20T21:17:50.313000Z\",\n\"url\": \"https://swapi.dev/api/people/4/\"\n}"
+var jsonCharacter = "{\n\"Id\": 1,\n\"name\": \"Darth Ezequiel\",\n\"height\": \"202\",\n\"mass\": \"136\",\n\"hair_color\": \"none\",\n\"skin_color\": \"white\",\n\"eye_color\": \"yellow\",\n\"birth_year\": \"41.9BBY\",\n\"birth_year_value\": -41.9,\n\"gender\": \"male\",\n\"homeworld\": \"https://swapi.dev/api/planets/1/\",\n\"created\": \"2014-12-10T15:18:20.704000Z\",\n\"edited\": \"2014-12-20T21:17:50.313000Z\",\n\"url\": \"https://swapi.dev/api/people/4/\"\n}"
 
 var CharacterIdentifier = model.CharacterIdentifier{
 	Id: 1,
@@ -40,19 +46,20 @@ var CharacterDetail = model.CharacterDetail{
 }
 
 var CharacterCacheModel = &cacheModel.CharacterCache{
-	Id:        CharacterDetail.Id.Id,
-	Name:      CharacterDetail.Character.Name,
-	Height:    CharacterDetail.Character.Height,
-	Mass:      CharacterDetail.Character.Mass,
-	HairColor: CharacterDetail.Character.HairColor,
-	SkinColor: CharacterDetail.Character.SkinColor,
-	EyeColor:  CharacterDetail.Character.EyeColor,
-	BirthYear: CharacterDetail.Character.BirthYear,
-	Gender:    CharacterDetail.Character.Gender,
-	Homeworld: CharacterDetail.Character.Homeworld,
-	Created:   CharacterDetail.Character.Created,
-	Edited:    CharacterDetail.Character.Edited,
-	Url:       CharacterDetail.Character.Url,
+	Id:             CharacterDetail.Id.Id,
+	Name:           CharacterDetail.Character.Name,
+	Height:         CharacterDetail.Character.Height.String(),
+	Mass:           CharacterDetail.Character.Mass.String(),
+	HairColor:      CharacterDetail.Character.HairColor,
+	SkinColor:      CharacterDetail.Character.SkinColor,
+	EyeColor:       CharacterDetail.Character.EyeColor,
+	BirthYear:      CharacterDetail.Character.BirthYear.String(),
+	BirthYearValue: CharacterDetail.Character.BirthYear.Year,
+	Gender:         CharacterDetail.Character.Gender,
+	Homeworld:      CharacterDetail.Character.Homeworld,
+	Created:        CharacterDetail.Character.Created,
+	Edited:         CharacterDetail.Character.Edited,
+	Url:            CharacterDetail.Character.Url,
 }
 
 var cacheOptions = cacheModel.CacheOptions{
@@ -178,3 +185,201 @@ func TestStarwarRedisAdapter_FindCharacterByIdError(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Nil(t, characterDetail)
 }
+
+func TestStarwarRedisAdapter_DeleteCharacter(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	redisCliMock, mock := redismock.NewClientMock()
+	key := strconv.Itoa(CharacterDetail.Id.Id)
+
+	mock.ExpectDel(key).SetVal(1)
+
+	adapter, _ := NewStarwarRedisAdapter(redisCliMock, &cacheOptions)
+
+	err := adapter.DeleteCharacter(&CharacterIdentifier, ctx)
+
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestStarwarRedisAdapter_DeleteCharacterError(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	redisCliMock, mock := redismock.NewClientMock()
+	key := strconv.Itoa(CharacterDetail.Id.Id)
+
+	mock.ExpectDel(key).SetErr(fmt.Errorf("generic Error"))
+
+	adapter, _ := NewStarwarRedisAdapter(redisCliMock, &cacheOptions)
+
+	err := adapter.DeleteCharacter(&CharacterIdentifier, ctx)
+
+	assert.NotNil(t, err)
+}
+
+func TestStarwarRedisAdapter_Ping(t *testing.T) {
+
+	type testCase struct {
+		name string
+		err  error
+	}
+
+	testCases := []testCase{
+		{name: "pong"},
+		{name: "connection refused", err: fmt.Errorf("dial tcp: connection refused")},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			redisCliMock, mock := redismock.NewClientMock()
+
+			if test.err != nil {
+				mock.ExpectPing().SetErr(test.err)
+			} else {
+				mock.ExpectPing().SetVal("PONG")
+			}
+
+			adapter, _ := NewStarwarRedisAdapter(redisCliMock, &cacheOptions)
+
+			err := adapter.Ping(context.Background())
+
+			assert.EqualValues(t, test.err, err)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestStarwarRedisAdapter_FindCharacterByIdUnknownMeasures(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	redisCliMock, mock := redismock.NewClientMock()
+	key := strconv.Itoa(CharacterDetail.Id.Id)
+
+	mock.ExpectGet(key).SetVal("{\"Id\": 1, \"name\": \"Jabba Desilijic Tiure\", \"height\": \"175\", \"mass\": \"1,358\"}")
+
+	adapter, _ := NewStarwarRedisAdapter(redisCliMock, &cacheOptions)
+
+	characterDetail, err := adapter.FindCharacterById(&CharacterIdentifier, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1358.0, *characterDetail.Character.Mass.Value)
+	assert.Equal(t, "1,358", characterDetail.Character.Mass.String())
+}
+
+func TestStarwarRedisAdapter_FindCharacterByIdWithLinks(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	linkedCharacter := character
+	linkedCharacter.Films = []model.ResourceLink{{Id: 1, Url: "https://swapi.dev/api/films/1/"}}
+	linkedCharacter.Starships = []model.ResourceLink{{Id: 13, Url: "https://swapi.dev/api/starships/13/"}}
+
+	linkedCacheModel := *CharacterCacheModel
+	linkedCacheModel.Films = []cacheModel.LinkCache{{Id: 1, Url: "https://swapi.dev/api/films/1/"}}
+	linkedCacheModel.Starships = []cacheModel.LinkCache{{Id: 13, Url: "https://swapi.dev/api/starships/13/"}}
+
+	characterJson, _ := json.Marshal(linkedCacheModel)
+
+	redisCliMock, mock := redismock.NewClientMock()
+	key := strconv.Itoa(CharacterDetail.Id.Id)
+
+	mock.ExpectGet(key).SetVal(string(characterJson))
+
+	adapter, _ := NewStarwarRedisAdapter(redisCliMock, &cacheOptions)
+
+	characterDetail, err := adapter.FindCharacterById(&CharacterIdentifier, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, linkedCharacter.Films, characterDetail.Character.Films)
+	assert.Equal(t, linkedCharacter.Starships, characterDetail.Character.Starships)
+	assert.Nil(t, characterDetail.Character.Species)
+}
+
+type CacheObserverMock struct {
+	mock.Mock
+}
+
+func (m *CacheObserverMock) ObserveCache(cache string, operation string, result string) {
+	m.Called(cache, operation, result)
+}
+
+func TestStarwarRedisAdapter_ObservesResults(t *testing.T) {
+
+	type testCase struct {
+		name      string
+		expect    func(mock redismock.ClientMock, key string)
+		call      func(adapter *StarwarRedisAdapter) error
+		operation string
+		result    string
+	}
+
+	find := func(adapter *StarwarRedisAdapter) error {
+		_, err := adapter.FindCharacterById(&CharacterIdentifier, context.Background())
+		return err
+	}
+
+	testCases := []testCase{
+		{name: "hit", expect: func(mock redismock.ClientMock, key string) { mock.ExpectGet(key).SetVal(jsonCharacter) },
+			call: find, operation: "find", result: cacheModel.CacheHit},
+		{name: "miss", expect: func(mock redismock.ClientMock, key string) { mock.ExpectGet(key).RedisNil() },
+			call: find, operation: "find", result: cacheModel.CacheMiss},
+		{name: "find error", expect: func(mock redismock.ClientMock, key string) { mock.ExpectGet(key).SetErr(fmt.Errorf("generic Error")) },
+			call: find, operation: "find", result: cacheModel.CacheError},
+		{name: "invalid json", expect: func(mock redismock.ClientMock, key string) { mock.ExpectGet(key).SetVal("{") },
+			call: find, operation: "find", result: cacheModel.CacheError},
+		{name: "save", expect: func(mock redismock.ClientMock, key string) {
+			characterJson, _ := json.Marshal(CharacterCacheModel)
+			mock.ExpectSet(key, characterJson, cacheOptions.Ttl).SetVal("1")
+		}, call: func(adapter *StarwarRedisAdapter) error {
+			return adapter.SaveCharacter(&CharacterDetail, context.Background())
+		}, operation: "save", result: cacheModel.CacheOk},
+		{name: "delete error", expect: func(mock redismock.ClientMock, key string) { mock.ExpectDel(key).SetErr(fmt.Errorf("generic Error")) },
+			call: func(adapter *StarwarRedisAdapter) error {
+				return adapter.DeleteCharacter(&CharacterIdentifier, context.Background())
+			}, operation: "delete", result: cacheModel.CacheError},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			redisCliMock, redisMock := redismock.NewClientMock()
+			test.expect(redisMock, strconv.Itoa(CharacterDetail.Id.Id))
+
+			observer := CacheObserverMock{}
+			observer.On("ObserveCache", "character", test.operation, test.result).Return()
+
+			adapter, _ := NewStarwarRedisAdapter(redisCliMock, &cacheModel.CacheOptions{Ttl: cacheOptions.Ttl, Observer: &observer})
+
+			test.call(adapter)
+
+			observer.AssertExpectations(t)
+			observer.AssertNumberOfCalls(t, "ObserveCache", 1)
+		})
+	}
+}
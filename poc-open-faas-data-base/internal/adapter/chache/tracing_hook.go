@@ -0,0 +1,83 @@
+package chache
+
+import (
+	"context"
+	"errors"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	cacheModel "handler/function/internal/adapter/chache/model"
+	"net"
+)
+
+var _ redis.Hook = (*TracingHook)(nil)
+
+var tracer = otel.Tracer("handler/function/internal/adapter/chache")
+
+// TracingHook runs every redis command in a span, a read is tagged with its cache result.
+type TracingHook struct{}
+
+func NewTracingHook() *TracingHook {
+	return &TracingHook{}
+}
+
+func (h *TracingHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+func (h *TracingHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		ctx, span := tracer.Start(ctx, "redis "+cmd.Name(), trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+			semconv.DBSystemRedis,
+			semconv.DBOperationKey.String(cmd.Name())))
+		defer span.End()
+
+		err := next(ctx, cmd)
+
+		if cmd.Name() == "get" {
+			span.SetAttributes(attribute.String("cache.result", cacheResult(err)))
+		}
+		recordRedisError(span, err)
+
+		return err
+	}
+}
+
+func (h *TracingHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		ctx, span := tracer.Start(ctx, "redis pipeline", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+			semconv.DBSystemRedis,
+			attribute.Int("db.redis.commands", len(cmds))))
+		defer span.End()
+
+		err := next(ctx, cmds)
+		recordRedisError(span, err)
+
+		return err
+	}
+}
+
+func cacheResult(err error) string {
+	switch {
+	case errors.Is(err, redis.Nil):
+		return cacheModel.CacheMiss
+	case err != nil:
+		return cacheModel.CacheError
+	default:
+		return cacheModel.CacheHit
+	}
+}
+
+// recordRedisError does not fail the span of a missing key, it is a cache miss.
+func recordRedisError(span trace.Span, err error) {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
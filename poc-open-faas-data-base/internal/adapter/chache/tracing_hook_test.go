@@ -0,0 +1,85 @@
+package chache
+
+import (
+	"context"
+	"errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	cacheModel "handler/function/internal/adapter/chache/model"
+	"testing"
+)
+
+var spanExporter = tracetest.NewInMemoryExporter()
+
+func init() {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(spanExporter)))
+}
+
+func TestTracingHook_ProcessHook(t *testing.T) {
+
+	type testCase struct {
+		name        string
+		cmd         redis.Cmder
+		err         error
+		spanName    string
+		cacheResult string
+		status      codes.Code
+	}
+
+	testCases := []testCase{
+		{name: "cache hit", cmd: redis.NewStringCmd(context.Background(), "get", "1"), spanName: "redis get", cacheResult: cacheModel.CacheHit, status: codes.Unset},
+		{name: "cache miss", cmd: redis.NewStringCmd(context.Background(), "get", "1"), err: redis.Nil, spanName: "redis get", cacheResult: cacheModel.CacheMiss, status: codes.Unset},
+		{name: "cache error", cmd: redis.NewStringCmd(context.Background(), "get", "1"), err: errors.New("connection refused"), spanName: "redis get", cacheResult: cacheModel.CacheError, status: codes.Error},
+		{name: "write", cmd: redis.NewIntCmd(context.Background(), "del", "1"), spanName: "redis del", status: codes.Unset},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			spanExporter.Reset()
+
+			process := NewTracingHook().ProcessHook(func(ctx context.Context, cmd redis.Cmder) error {
+				return test.err
+			})
+
+			err := process(context.Background(), test.cmd)
+
+			assert.Equal(t, test.err, err)
+
+			spans := spanExporter.GetSpans()
+			if assert.Len(t, spans, 1) {
+				assert.Equal(t, test.spanName, spans[0].Name)
+				assert.Equal(t, test.status, spans[0].Status.Code)
+				assert.Contains(t, spans[0].Attributes, attribute.String("db.system", "redis"))
+				if test.cacheResult != "" {
+					assert.Contains(t, spans[0].Attributes, attribute.String("cache.result", test.cacheResult))
+				}
+			}
+		})
+	}
+}
+
+func TestTracingHook_ProcessPipelineHook(t *testing.T) {
+	spanExporter.Reset()
+
+	process := NewTracingHook().ProcessPipelineHook(func(ctx context.Context, cmds []redis.Cmder) error {
+		return nil
+	})
+
+	err := process(context.Background(), []redis.Cmder{
+		redis.NewStringCmd(context.Background(), "get", "1"),
+		redis.NewIntCmd(context.Background(), "del", "2"),
+	})
+
+	assert.Nil(t, err)
+
+	spans := spanExporter.GetSpans()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "redis pipeline", spans[0].Name)
+		assert.Contains(t, spans[0].Attributes, attribute.Int("db.redis.commands", 2))
+	}
+}
@@ -0,0 +1,19 @@
+package clock
+
+import (
+	"handler/function/internal/application/port/out"
+	"time"
+)
+
+var _ out.Clock = (*SystemClock)(nil)
+
+type SystemClock struct {
+}
+
+func NewSystemClock() *SystemClock {
+	return &SystemClock{}
+}
+
+func (c *SystemClock) Now() time.Time {
+	return time.Now().UTC()
+}
@@ -1,7 +1,6 @@
 package controller
 
 import (
-	"encoding/json"
 	"fmt"
 	controllerModel "handler/function/internal/adapter/controller/model"
 	"handler/function/internal/application/model"
@@ -13,39 +12,104 @@ import (
 	"strings"
 )
 
+const expandHomeworld = "homeworld"
+
 type StarWarController struct {
 	createCharacter in.CreateCharacter
 	findCharacter   in.FindCharacter
+	updateCharacter in.UpdateCharacter
+	listCharacters  in.ListCharacters
+	findPlanet      in.FindPlanet
 }
 
 func NewStarWarController(
 	createCharacter in.CreateCharacter,
 	findCharacter in.FindCharacter,
+	updateCharacter in.UpdateCharacter,
+	listCharacters in.ListCharacters,
+	findPlanet in.FindPlanet,
 ) *StarWarController {
 	return &StarWarController{
 		createCharacter: createCharacter,
 		findCharacter:   findCharacter,
+		updateCharacter: updateCharacter,
+		listCharacters:  listCharacters,
+		findPlanet:      findPlanet,
+	}
+}
+
+// getPathId reads the identifier from the last segment of the path.
+func getPathId(r *http.Request) (int, error) {
+	split := strings.Split(r.URL.Path, "/")
+	count := len(split)
+
+	return strconv.Atoi(split[count-1])
+}
+
+// getParentPathId reads the identifier placed before a nested collection, e.g. /characters/{id}/films.
+func getParentPathId(r *http.Request) (int, error) {
+	split := strings.Split(r.URL.Path, "/")
+	count := len(split)
+
+	if count < 2 {
+		return 0, fmt.Errorf("missing identifier in path %s", r.URL.Path)
+	}
+
+	return strconv.Atoi(split[count-2])
+}
+
+func getCharacterIdentifier(r *http.Request) (*model.CharacterIdentifier, error) {
+	pathParam, pathError := getPathId(r)
+
+	if pathError != nil {
+		return nil, pathError
+	}
+
+	return &model.CharacterIdentifier{Id: pathParam}, nil
+}
+
+// getExpand reads ?expand=homeworld, the only relation that can be embedded in a character.
+func getExpand(r *http.Request) (map[string]bool, error) {
+	expand := map[string]bool{}
+
+	value := r.URL.Query().Get("expand")
+	if value == "" {
+		return expand, nil
+	}
+
+	for _, relation := range strings.Split(value, ",") {
+		relation = strings.TrimSpace(relation)
+		if relation != expandHomeworld {
+			return nil, badRequest(fmt.Sprintf("Invalid expand: %s", relation))
+		}
+		expand[relation] = true
 	}
+
+	return expand, nil
 }
 
 func GetRequestBody(r *http.Request) (*controllerModel.CreaterCharacterRequest, *pkg.GenericException) {
 
-	decoder := json.NewDecoder(r.Body)
 	requestBody := &controllerModel.CreaterCharacterRequest{}
-	err := decoder.Decode(requestBody)
 
-	if err != nil {
-		log.Printf("Error reading request body: %s", err.Error())
-		return nil, &pkg.GenericException{
-			Msj:        fmt.Sprintf("Invalid request body: %s", err.Error()),
-			StatusCode: http.StatusBadRequest,
-		}
+	if err := decodeRequest(r, requestBody); err != nil {
+		return nil, err
 	}
 
 	return requestBody, nil
 }
 
+// isImportMode keeps the client timestamps on create (?mode=import), used to load the original SWAPI dataset.
+// Only the requests let through by ImportAuthorized can use it.
+func isImportMode(r *http.Request) bool {
+	authorized, _ := r.Context().Value(importAuthorizedKey{}).(bool)
+	return authorized && r.URL.Query().Get("mode") == "import"
+}
+
 func (c *StarWarController) CreateStarWarCharacter(w http.ResponseWriter, r *http.Request) {
+	r, span := startSpan(r, "StarWarController.CreateStarWarCharacter")
+	defer span.End()
+
 	ctx := r.Context()
 
 	requestBody, requestError := GetRequestBody(r)
@@ -56,8 +120,60 @@ func (c *StarWarController) CreateStarWarCharacter(w http.ResponseWriter, r *htt
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	searchResult, err := c.createCharacter.CreateCharacter(requestBody.ToDomain(), ctx)
+	character, domainError := requestBody.ToDomain(isImportMode(r))
+
+	if domainError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(domainError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	searchResult, err := c.createCharacter.CreateCharacter(character, ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, controllerModel.CreateResponseFromDomain(searchResult))
+}
+
+func (c *StarWarController) FindStarWarCharacter(w http.ResponseWriter, r *http.Request) {
+	r, span := startSpan(r, "StarWarController.FindStarWarCharacter")
+	defer span.End()
+
+	ctx := r.Context()
+
+	characterIdentifier, pathError := getCharacterIdentifier(r)
+
+	if pathError != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Invalid character identifier"))
+		return
+	}
+
+	expand, expandError := getExpand(r)
+
+	if expandError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(expandError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	projection, projectionError := getProjection(r)
+
+	if projectionError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(projectionError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	findResult, err := c.findCharacter.FindCharacterProjection(characterIdentifier, projection, ctx)
 
 	if err != nil {
 		statusCode, errorMsj := pkg.GetErrorDetail(err)
@@ -66,25 +182,51 @@ func (c *StarWarController) CreateStarWarCharacter(w http.ResponseWriter, r *htt
 		return
 	}
 
-	jsonResult, jsonError := json.Marshal(controllerModel.CreateResponseFromDomain(searchResult))
-	if jsonError != nil {
-		log.Printf("Error converting to json %s", jsonError.Error())
+	// a partial response is another representation, it is sent without the ETag of the character.
+	if projection == nil {
+		w.Header().Set("ETag", formatETag(findResult.Version))
+	}
+
+	// the ETag only covers the character, an expanded response is always sent in full.
+	if projection == nil && len(expand) == 0 && matchIfNoneMatch(r, findResult.Version) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	findResponse := controllerModel.FindResponseFromDomain(findResult)
+
+	if expand[expandHomeworld] && projection.Includes("homeworld") && findResult.Character.HomeworldId != nil {
+		planetIdentifier := &model.PlanetIdentifier{Id: *findResult.Character.HomeworldId}
+		planetResult, planetErr := c.findPlanet.FindPlanet(planetIdentifier, ctx)
+
+		if planetErr != nil {
+			statusCode, errorMsj := pkg.GetErrorDetail(planetErr)
+			w.WriteHeader(statusCode)
+			w.Write([]byte(errorMsj))
+			return
+		}
+
+		findResponse.HomeworldPlanet = controllerModel.FindPlanetResponseFromDomain(planetResult)
+	}
+
+	projectedResponse, projectionError := controllerModel.ProjectResponse(findResponse, projection)
+	if projectionError != nil {
+		log.Printf("Error converting to json %s", projectionError.Error())
 		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(jsonError.Error()))
+		w.Write([]byte(projectionError.Error()))
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-	w.Write(jsonResult)
+	writeResponse(w, r, http.StatusOK, projectedResponse)
 }
 
-func (c *StarWarController) FindStarWarCharacter(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+func (c *StarWarController) UpdateStarWarCharacter(w http.ResponseWriter, r *http.Request) {
+	r, span := startSpan(r, "StarWarController.UpdateStarWarCharacter")
+	defer span.End()
 
-	split := strings.Split(r.URL.Path, "/")
-	count := len(split)
+	ctx := r.Context()
 
-	pathParam, pathError := strconv.Atoi(split[count-1])
+	characterIdentifier, pathError := getCharacterIdentifier(r)
 
 	if pathError != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -92,10 +234,72 @@ func (c *StarWarController) FindStarWarCharacter(w http.ResponseWriter, r *http.
 		return
 	}
 
-	characterIdentifier := &model.CharacterIdentifier{Id: pathParam}
+	if r.Header.Get("If-Match") == "" {
+		w.WriteHeader(http.StatusPreconditionRequired)
+		w.Write([]byte("If-Match header is required"))
+		return
+	}
+
+	version, validETag := getIfMatchVersion(r)
+
+	if !validETag {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		w.Write([]byte("If-Match header must be the character ETag"))
+		return
+	}
+
+	requestBody, requestError := GetRequestBody(r)
+
+	if requestError != nil {
+		w.WriteHeader(requestError.StatusCode)
+		w.Write([]byte(requestError.Msj))
+		return
+	}
+
+	character, domainError := requestBody.ToDomain(false)
+
+	if domainError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(domainError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	characterDetail := &model.CharacterDetail{
+		Id:        characterIdentifier,
+		Character: character,
+		Version:   version,
+	}
+
+	updateResult, err := c.updateCharacter.UpdateCharacter(characterDetail, ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	w.Header().Set("ETag", formatETag(updateResult.Version))
+	writeResponse(w, r, http.StatusOK, controllerModel.FindResponseFromDomain(updateResult))
+}
+
+func (c *StarWarController) ListStarWarCharacters(w http.ResponseWriter, r *http.Request) {
+	r, span := startSpan(r, "StarWarController.ListStarWarCharacters")
+	defer span.End()
+
+	ctx := r.Context()
+
+	characterQuery, queryError := getCharacterQuery(r)
+
+	if queryError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(queryError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
 
-	w.Header().Set("Content-Type", "application/json")
-	findResult, err := c.findCharacter.FindCharacter(characterIdentifier, ctx)
+	listResult, err := c.listCharacters.ListCharacters(characterQuery, ctx)
 
 	if err != nil {
 		statusCode, errorMsj := pkg.GetErrorDetail(err)
@@ -104,14 +308,13 @@ func (c *StarWarController) FindStarWarCharacter(w http.ResponseWriter, r *http.
 		return
 	}
 
-	jsonResult, jsonError := json.Marshal(controllerModel.FindResponseFromDomain(findResult))
-	if jsonError != nil {
-		log.Printf("Error converting to json %s", jsonError.Error())
+	projectedResponse, projectionError := controllerModel.ProjectResponse(controllerModel.ListResponseFromDomain(listResult), characterQuery.Projection)
+	if projectionError != nil {
+		log.Printf("Error converting to json %s", projectionError.Error())
 		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(jsonError.Error()))
+		w.Write([]byte(projectionError.Error()))
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-	w.Write(jsonResult)
+	writeResponse(w, r, http.StatusOK, projectedResponse)
 }
@@ -9,23 +9,30 @@ import (
 	"github.com/stretchr/testify/mock"
 	controllerModel "handler/function/internal/adapter/controller/model"
 	"handler/function/internal/application/model"
+	"handler/function/pkg"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 )
 
+var characterHeight = 202.0
+var characterMass = 136.0
+var characterBirthYear = -41.9
+
 var character = model.Character{
 	Name:      "Darth Ezequiel",
-	Height:    "202",
-	Mass:      "136",
+	Height:    model.NewMeasure(&characterHeight, model.HeightUnit),
+	Mass:      model.NewMeasure(&characterMass, model.MassUnit),
 	HairColor: "none",
 	SkinColor: "white",
 	EyeColor:  "yellow",
-	BirthYear: "41.9BBY",
+	BirthYear: model.NewBirthYear(&characterBirthYear, "41.9BBY"),
 	Gender:    "male",
 	Homeworld: "https://swapi.dev/api/planets/1/",
-	Created:   "2014-12-10T15:18:20.704000Z",
-	Edited:    "2014-12-20T21:17:50.313000Z",
+	Created:   time.Date(2014, 12, 10, 15, 18, 20, 704000000, time.UTC),
+	Edited:    time.Date(2014, 12, 20, 21, 17, 50, 313000000, time.UTC),
 	Url:       "https://swapi.dev/api/people/4/",
 }
 var CreateCharacterIdentifier = model.CharacterIdentifier{
@@ -43,6 +50,12 @@ type CreateCharacterControllerMock struct {
 type FindCharacterControllerMock struct {
 	mock.Mock
 }
+type UpdateCharacterControllerMock struct {
+	mock.Mock
+}
+type ListCharactersControllerMock struct {
+	mock.Mock
+}
 
 func (c *CreateCharacterControllerMock) CreateCharacter(
 	character *model.Character,
@@ -78,11 +91,70 @@ func (f *FindCharacterControllerMock) FindCharacter(
 
 }
 
+// FindCharacterProjection without a projection is FindCharacter, as in the use case.
+func (f *FindCharacterControllerMock) FindCharacterProjection(
+	character *model.CharacterIdentifier,
+	projection *model.CharacterProjection,
+	ctx context.Context) (*model.CharacterDetail, error) {
+
+	if projection == nil {
+		return f.FindCharacter(character, ctx)
+	}
+
+	args := f.Called(character, projection, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	characterDetail := firstParameter.(*model.CharacterDetail)
+
+	return characterDetail, nil
+
+}
+
+func (u *UpdateCharacterControllerMock) UpdateCharacter(
+	character *model.CharacterDetail,
+	ctx context.Context) (*model.CharacterDetail, error) {
+
+	args := u.Called(character, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	characterDetail := firstParameter.(*model.CharacterDetail)
+
+	return characterDetail, nil
+
+}
+
+func (l *ListCharactersControllerMock) ListCharacters(
+	query *model.CharacterQuery,
+	ctx context.Context) (*model.CharacterPage, error) {
+
+	args := l.Called(query, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	characterPage := firstParameter.(*model.CharacterPage)
+
+	return characterPage, nil
+
+}
+
 func TestNewStarWarController(t *testing.T) {
 	controllerMock := CreateCharacterControllerMock{}
 	characterControllerMock := FindCharacterControllerMock{}
 
-	controller := NewStarWarController(&controllerMock, &characterControllerMock)
+	updateControllerMock := UpdateCharacterControllerMock{}
+
+	controller := NewStarWarController(&controllerMock, &characterControllerMock, &updateControllerMock, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
 	assert.NotNil(t, controller)
 
 }
@@ -170,10 +242,10 @@ func TestStarWarController_CreateStarWarCharacter(t *testing.T) {
 	createControllerMock := CreateCharacterControllerMock{}
 	findCharacterControllerMock := FindCharacterControllerMock{}
 
-	createControllerMock.On("CreateCharacter", mock.IsType(&model.Character{}), newRequest.Context()).
+	createControllerMock.On("CreateCharacter", mock.IsType(&model.Character{}), controllerContext(newRequest)).
 		Return(&CreateCharacterIdentifier, nil)
 
-	controller := NewStarWarController(&createControllerMock, &findCharacterControllerMock)
+	controller := NewStarWarController(&createControllerMock, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
 
 	controller.CreateStarWarCharacter(response, newRequest)
 	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
@@ -195,10 +267,10 @@ func TestStarWarController_CreateStarWarCharacterErrorBody(t *testing.T) {
 	createControllerMock := CreateCharacterControllerMock{}
 	findCharacterControllerMock := FindCharacterControllerMock{}
 
-	createControllerMock.On("CreateCharacter", mock.IsType(&model.Character{}), newRequest.Context()).
+	createControllerMock.On("CreateCharacter", mock.IsType(&model.Character{}), controllerContext(newRequest)).
 		Return(&CreateCharacterIdentifier, nil)
 
-	controller := NewStarWarController(&createControllerMock, &findCharacterControllerMock)
+	controller := NewStarWarController(&createControllerMock, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
 
 	controller.CreateStarWarCharacter(response, newRequest)
 	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
@@ -235,10 +307,10 @@ func TestStarWarController_CreateStarWarCharacterError(t *testing.T) {
 	createControllerMock := CreateCharacterControllerMock{}
 	findCharacterControllerMock := FindCharacterControllerMock{}
 
-	createControllerMock.On("CreateCharacter", mock.IsType(&model.Character{}), newRequest.Context()).
+	createControllerMock.On("CreateCharacter", mock.IsType(&model.Character{}), controllerContext(newRequest)).
 		Return(nil, fmt.Errorf("generic error"))
 
-	controller := NewStarWarController(&createControllerMock, &findCharacterControllerMock)
+	controller := NewStarWarController(&createControllerMock, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
 
 	controller.CreateStarWarCharacter(response, newRequest)
 	assert.EqualValues(t, http.StatusInternalServerError, response.Result().StatusCode)
@@ -258,10 +330,10 @@ func TestStarWarController_FindStarWarCharacterOk(t *testing.T) {
 	createControllerMock := CreateCharacterControllerMock{}
 	findCharacterControllerMock := FindCharacterControllerMock{}
 
-	findCharacterControllerMock.On("FindCharacter", mock.IsType(&model.CharacterIdentifier{}), newRequest.Context()).
+	findCharacterControllerMock.On("FindCharacter", mock.IsType(&model.CharacterIdentifier{}), controllerContext(newRequest)).
 		Return(&CharacterDetail, nil)
 
-	controller := NewStarWarController(&createControllerMock, &findCharacterControllerMock)
+	controller := NewStarWarController(&createControllerMock, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
 
 	controller.FindStarWarCharacter(response, newRequest)
 	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
@@ -281,10 +353,10 @@ func TestStarWarController_FindStarWarCharacterInvalidUrl(t *testing.T) {
 	createControllerMock := CreateCharacterControllerMock{}
 	findCharacterControllerMock := FindCharacterControllerMock{}
 
-	findCharacterControllerMock.On("FindCharacter", mock.IsType(&model.CharacterIdentifier{}), newRequest.Context()).
+	findCharacterControllerMock.On("FindCharacter", mock.IsType(&model.CharacterIdentifier{}), controllerContext(newRequest)).
 		Return(&CharacterDetail, nil)
 
-	controller := NewStarWarController(&createControllerMock, &findCharacterControllerMock)
+	controller := NewStarWarController(&createControllerMock, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
 
 	controller.FindStarWarCharacter(response, newRequest)
 	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
@@ -304,12 +376,746 @@ func TestStarWarController_FindStarWarCharacterError(t *testing.T) {
 	createControllerMock := CreateCharacterControllerMock{}
 	findCharacterControllerMock := FindCharacterControllerMock{}
 
-	findCharacterControllerMock.On("FindCharacter", mock.IsType(&model.CharacterIdentifier{}), newRequest.Context()).
+	findCharacterControllerMock.On("FindCharacter", mock.IsType(&model.CharacterIdentifier{}), controllerContext(newRequest)).
 		Return(nil, fmt.Errorf("generic error"))
 
-	controller := NewStarWarController(&createControllerMock, &findCharacterControllerMock)
+	controller := NewStarWarController(&createControllerMock, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
 
 	controller.FindStarWarCharacter(response, newRequest)
 	assert.EqualValues(t, http.StatusInternalServerError, response.Result().StatusCode)
 
 }
+
+func TestStarWarController_FindStarWarCharacterETag(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	findCharacterControllerMock := FindCharacterControllerMock{}
+
+	findCharacterControllerMock.On("FindCharacter", mock.IsType(&model.CharacterIdentifier{}), controllerContext(newRequest)).
+		Return(&model.CharacterDetail{Id: &CreateCharacterIdentifier, Character: &character, Version: 3}, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.FindStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, "\"3\"", response.Result().Header.Get("ETag"))
+
+}
+
+func TestStarWarController_FindStarWarCharacterNotModified(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+	newRequest.Header.Set("If-None-Match", "\"2\", W/\"3\"")
+
+	response := httptest.NewRecorder()
+
+	findCharacterControllerMock := FindCharacterControllerMock{}
+
+	findCharacterControllerMock.On("FindCharacter", mock.IsType(&model.CharacterIdentifier{}), controllerContext(newRequest)).
+		Return(&model.CharacterDetail{Id: &CreateCharacterIdentifier, Character: &character, Version: 3}, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.FindStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusNotModified, response.Result().StatusCode)
+	assert.EqualValues(t, 0, response.Body.Len())
+
+}
+
+func TestStarWarController_FindStarWarCharacterModified(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+	newRequest.Header.Set("If-None-Match", "\"2\"")
+
+	response := httptest.NewRecorder()
+
+	findCharacterControllerMock := FindCharacterControllerMock{}
+
+	findCharacterControllerMock.On("FindCharacter", mock.IsType(&model.CharacterIdentifier{}), controllerContext(newRequest)).
+		Return(&model.CharacterDetail{Id: &CreateCharacterIdentifier, Character: &character, Version: 3}, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.FindStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+
+}
+
+func newUpdateCharacterRequest(ifMatch string) *http.Request {
+	request := controllerModel.CreaterCharacterRequest{
+		Name:      "Darth Ezequiel",
+		Height:    "202",
+		Mass:      "136",
+		HairColor: "none",
+		SkinColor: "white",
+		EyeColor:  "yellow",
+		BirthYear: "41.9BBY",
+		Gender:    "male",
+		Homeworld: "https://swapi.dev/api/planets/1/",
+		Created:   "2014-12-10T15:18:20.704000Z",
+		Edited:    "2014-12-20T21:17:50.313000Z",
+		Url:       "https://swapi.dev/api/people/4/",
+	}
+
+	marshal, _ := json.Marshal(request)
+
+	newRequest := httptest.NewRequest(
+		http.MethodPut,
+		"/api/v1/starwar/characters/1",
+		bytes.NewReader(marshal),
+	)
+
+	if ifMatch != "" {
+		newRequest.Header.Set("If-Match", ifMatch)
+	}
+
+	return newRequest
+}
+
+func TestStarWarController_UpdateStarWarCharacter(t *testing.T) {
+
+	newRequest := newUpdateCharacterRequest("\"1\"")
+
+	response := httptest.NewRecorder()
+
+	updateControllerMock := UpdateCharacterControllerMock{}
+
+	updateControllerMock.On("UpdateCharacter", mock.MatchedBy(func(c *model.CharacterDetail) bool {
+		return c.Version == 1 && c.Id.Id == 1
+	}), controllerContext(newRequest)).
+		Return(&model.CharacterDetail{Id: &CreateCharacterIdentifier, Character: &character, Version: 2}, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &updateControllerMock, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.UpdateStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, "\"2\"", response.Result().Header.Get("ETag"))
+
+}
+
+func TestStarWarController_UpdateStarWarCharacterWithoutIfMatch(t *testing.T) {
+
+	newRequest := newUpdateCharacterRequest("")
+
+	response := httptest.NewRecorder()
+
+	updateControllerMock := UpdateCharacterControllerMock{}
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &updateControllerMock, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.UpdateStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusPreconditionRequired, response.Result().StatusCode)
+	updateControllerMock.AssertNotCalled(t, "UpdateCharacter", mock.Anything, mock.Anything)
+
+}
+
+func TestStarWarController_UpdateStarWarCharacterAnyVersion(t *testing.T) {
+
+	newRequest := newUpdateCharacterRequest("*")
+
+	response := httptest.NewRecorder()
+
+	updateControllerMock := UpdateCharacterControllerMock{}
+
+	updateControllerMock.On("UpdateCharacter", mock.MatchedBy(func(c *model.CharacterDetail) bool {
+		return c.Version == model.AnyVersion && c.Id.Id == 1
+	}), controllerContext(newRequest)).
+		Return(&model.CharacterDetail{Id: &CreateCharacterIdentifier, Character: &character, Version: 4}, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &updateControllerMock, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.UpdateStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, "\"4\"", response.Result().Header.Get("ETag"))
+
+}
+
+func TestStarWarController_UpdateStarWarCharacterInvalidIfMatch(t *testing.T) {
+
+	type testCase struct {
+		name    string
+		ifMatch string
+	}
+
+	testCases := []testCase{
+		{name: "weak validator", ifMatch: "W/\"1\""},
+		{name: "not an etag", ifMatch: "1"},
+		{name: "unknown version", ifMatch: "\"0\""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			newRequest := newUpdateCharacterRequest(test.ifMatch)
+
+			response := httptest.NewRecorder()
+
+			updateControllerMock := UpdateCharacterControllerMock{}
+
+			controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &updateControllerMock, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+			controller.UpdateStarWarCharacter(response, newRequest)
+			assert.EqualValues(t, http.StatusPreconditionFailed, response.Result().StatusCode)
+			updateControllerMock.AssertNotCalled(t, "UpdateCharacter", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestStarWarController_UpdateStarWarCharacterVersionMismatch(t *testing.T) {
+
+	newRequest := newUpdateCharacterRequest("\"1\"")
+
+	response := httptest.NewRecorder()
+
+	updateControllerMock := UpdateCharacterControllerMock{}
+
+	updateControllerMock.On("UpdateCharacter", mock.IsType(&model.CharacterDetail{}), controllerContext(newRequest)).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusPreconditionFailed, Msj: "Character 1 was modified"})
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &updateControllerMock, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.UpdateStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusPreconditionFailed, response.Result().StatusCode)
+
+}
+
+func TestStarWarController_CreateStarWarCharacterIgnoresTimestamps(t *testing.T) {
+	request := controllerModel.CreaterCharacterRequest{
+		Name:    "Darth Ezequiel",
+		Created: "2014-12-10T15:18:20.704000Z",
+		Edited:  "2014-12-20T21:17:50.313000Z",
+	}
+
+	marshal, _ := json.Marshal(request)
+
+	newRequest := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/starwar/characters",
+		bytes.NewReader(marshal),
+	)
+
+	response := httptest.NewRecorder()
+
+	createControllerMock := CreateCharacterControllerMock{}
+
+	createControllerMock.On("CreateCharacter", mock.MatchedBy(func(c *model.Character) bool {
+		return c.Created.IsZero() && c.Edited.IsZero()
+	}), controllerContext(newRequest)).
+		Return(&CreateCharacterIdentifier, nil)
+
+	controller := NewStarWarController(&createControllerMock, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.CreateStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+}
+
+func TestStarWarController_CreateStarWarCharacterImportMode(t *testing.T) {
+
+	type testCase struct {
+		name            string
+		configuredToken string
+		token           string
+		keepsTimestamps bool
+	}
+
+	testCases := []testCase{
+		{name: "authorized", configuredToken: "import-secret", token: "import-secret", keepsTimestamps: true},
+		{name: "without token", configuredToken: "import-secret"},
+		{name: "wrong token", configuredToken: "import-secret", token: "guess"},
+		{name: "import disabled", token: ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			request := controllerModel.CreaterCharacterRequest{
+				Name:    "Darth Ezequiel",
+				Created: "2014-12-10T15:18:20.704000Z",
+				Edited:  "2014-12-20T21:17:50.313000Z",
+			}
+
+			marshal, _ := json.Marshal(request)
+
+			newRequest := httptest.NewRequest(
+				http.MethodPost,
+				"/api/v1/starwar/characters?mode=import",
+				bytes.NewReader(marshal),
+			)
+			if test.token != "" {
+				newRequest.Header.Set(importTokenHeader, test.token)
+			}
+
+			response := httptest.NewRecorder()
+
+			createControllerMock := CreateCharacterControllerMock{}
+
+			createControllerMock.On("CreateCharacter", mock.MatchedBy(func(c *model.Character) bool {
+				if test.keepsTimestamps {
+					return c.Created.Equal(character.Created) && c.Edited.Equal(character.Edited)
+				}
+				return c.Created.IsZero() && c.Edited.IsZero()
+			}), mock.Anything).
+				Return(&CreateCharacterIdentifier, nil)
+
+			controller := NewStarWarController(&createControllerMock, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+			ImportAuthorized(test.configuredToken, controller.CreateStarWarCharacter)(response, newRequest)
+			assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+			createControllerMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStarWarController_CreateStarWarCharacterImportModeInvalidTimestamp(t *testing.T) {
+	request := controllerModel.CreaterCharacterRequest{
+		Name:    "Darth Ezequiel",
+		Created: "10/12/2014",
+	}
+
+	marshal, _ := json.Marshal(request)
+
+	newRequest := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/starwar/characters?mode=import",
+		bytes.NewReader(marshal),
+	)
+	newRequest.Header.Set(importTokenHeader, "import-secret")
+
+	response := httptest.NewRecorder()
+
+	createControllerMock := CreateCharacterControllerMock{}
+
+	controller := NewStarWarController(&createControllerMock, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	ImportAuthorized("import-secret", controller.CreateStarWarCharacter)(response, newRequest)
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+	createControllerMock.AssertNotCalled(t, "CreateCharacter", mock.Anything, mock.Anything)
+}
+
+func TestStarWarController_CreateStarWarCharacterInvalidMass(t *testing.T) {
+	request := controllerModel.CreaterCharacterRequest{
+		Name: "Darth Ezequiel",
+		Mass: "heavy",
+	}
+
+	marshal, _ := json.Marshal(request)
+
+	newRequest := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/starwar/characters",
+		bytes.NewReader(marshal),
+	)
+
+	response := httptest.NewRecorder()
+
+	createControllerMock := CreateCharacterControllerMock{}
+
+	controller := NewStarWarController(&createControllerMock, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.CreateStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+	createControllerMock.AssertNotCalled(t, "CreateCharacter", mock.Anything, mock.Anything)
+}
+
+func TestStarWarController_ListStarWarCharacters(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters?min_height=150&max_mass=140.5&sort=-height,name&limit=5&offset=10",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	listControllerMock := ListCharactersControllerMock{}
+
+	listControllerMock.On("ListCharacters", mock.MatchedBy(func(q *model.CharacterQuery) bool {
+		return *q.Height.Min == 150 && q.Height.Max == nil && *q.Mass.Max == 140.5 &&
+			q.Limit == 5 && q.Offset == 10 &&
+			len(q.Sort) == 2 && q.Sort[0].Field == "height" && q.Sort[0].Descending && !q.Sort[1].Descending
+	}), controllerContext(newRequest)).
+		Return(&model.CharacterPage{Characters: []*model.CharacterDetail{&CharacterDetail}, Total: 11, Limit: 5, Offset: 10}, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &listControllerMock, &FindPlanetControllerMock{})
+
+	controller.ListStarWarCharacters(response, newRequest)
+
+	listResponse := controllerModel.ListCharactersResponse{}
+	json.NewDecoder(response.Result().Body).Decode(&listResponse)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, 11, listResponse.Count)
+	assert.EqualValues(t, "202", listResponse.Results[0].Height)
+}
+
+func TestStarWarController_ListStarWarCharactersInvalidQuery(t *testing.T) {
+
+	testCase := []string{
+		"/api/v1/starwar/characters?sort=skin_color",
+		"/api/v1/starwar/characters?min_height=tall",
+		"/api/v1/starwar/characters?min_height=NaN",
+		"/api/v1/starwar/characters?max_mass=Inf",
+		"/api/v1/starwar/characters?limit=-1",
+		"/api/v1/starwar/characters?filter=" + url.QueryEscape(`height gt "tall"`),
+	}
+
+	for _, path := range testCase {
+		t.Run(path, func(t *testing.T) {
+			newRequest := httptest.NewRequest(http.MethodGet, path, nil)
+
+			response := httptest.NewRecorder()
+
+			listControllerMock := ListCharactersControllerMock{}
+
+			controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &listControllerMock, &FindPlanetControllerMock{})
+
+			controller.ListStarWarCharacters(response, newRequest)
+			assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+			listControllerMock.AssertNotCalled(t, "ListCharacters", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestStarWarController_ListStarWarCharactersFilter(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters?filter="+url.QueryEscape(`gender eq "female" and height gt 170`),
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	listControllerMock := ListCharactersControllerMock{}
+
+	listControllerMock.On("ListCharacters", mock.MatchedBy(func(q *model.CharacterQuery) bool {
+		return q.Filter == model.FilterLogical{
+			Operator: model.FilterAnd,
+			Left:     model.FilterComparison{Field: "gender", Operator: model.FilterEq, Value: "female"},
+			Right:    model.FilterComparison{Field: "height", Operator: model.FilterGt, Value: 170.0},
+		}
+	}), controllerContext(newRequest)).
+		Return(&model.CharacterPage{Characters: []*model.CharacterDetail{&CharacterDetail}, Total: 1}, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &listControllerMock, &FindPlanetControllerMock{})
+
+	controller.ListStarWarCharacters(response, newRequest)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+}
+
+func TestStarWarController_ListStarWarCharactersFilterSyntaxError(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters?filter="+url.QueryEscape(`gender eq "female" and version gt 1`),
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.ListStarWarCharacters(response, newRequest)
+
+	body := new(bytes.Buffer)
+	body.ReadFrom(response.Result().Body)
+
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+	assert.EqualValues(t, `Invalid filter at position 24: unknown field "version"`, body.String())
+}
+
+func TestStarWarController_ListStarWarCharactersBornBetween(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters?born_from=50BBY&born_to=0ABY&sort=birth_year",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	listControllerMock := ListCharactersControllerMock{}
+
+	listControllerMock.On("ListCharacters", mock.MatchedBy(func(q *model.CharacterQuery) bool {
+		return *q.BirthYear.Min == -50 && *q.BirthYear.Max == 0 && q.Sort[0].Field == "birth_year"
+	}), controllerContext(newRequest)).
+		Return(&model.CharacterPage{Characters: []*model.CharacterDetail{&CharacterDetail}, Total: 1}, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &listControllerMock, &FindPlanetControllerMock{})
+
+	controller.ListStarWarCharacters(response, newRequest)
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+}
+
+func TestStarWarController_ListStarWarCharactersInvalidBirthYear(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters?born_from=unknown",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	listControllerMock := ListCharactersControllerMock{}
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &listControllerMock, &FindPlanetControllerMock{})
+
+	controller.ListStarWarCharacters(response, newRequest)
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+}
+
+func TestStarWarController_CreateStarWarCharacterInvalidBirthYear(t *testing.T) {
+	request := controllerModel.CreaterCharacterRequest{
+		Name:      "Darth Ezequiel",
+		BirthYear: "1990",
+	}
+
+	marshal, _ := json.Marshal(request)
+
+	newRequest := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/starwar/characters",
+		bytes.NewReader(marshal),
+	)
+
+	response := httptest.NewRecorder()
+
+	createControllerMock := CreateCharacterControllerMock{}
+
+	controller := NewStarWarController(&createControllerMock, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.CreateStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+}
+
+func TestStarWarController_FindStarWarCharacterExpandHomeworld(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1?expand=homeworld",
+		nil,
+	)
+	newRequest.Header.Set("If-None-Match", "\"3\"")
+
+	response := httptest.NewRecorder()
+
+	homeworldId := 1
+	expandedCharacter := character
+	expandedCharacter.HomeworldId = &homeworldId
+
+	findCharacterControllerMock := FindCharacterControllerMock{}
+	findCharacterControllerMock.On("FindCharacter", mock.IsType(&model.CharacterIdentifier{}), controllerContext(newRequest)).
+		Return(&model.CharacterDetail{Id: &CreateCharacterIdentifier, Character: &expandedCharacter, Version: 3}, nil)
+
+	findPlanetControllerMock := FindPlanetControllerMock{}
+	findPlanetControllerMock.On("FindPlanet", &PlanetIdentifier, controllerContext(newRequest)).
+		Return(&model.PlanetDetail{Id: &PlanetIdentifier, Planet: &planet, Version: 1}, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &findPlanetControllerMock)
+
+	controller.FindStarWarCharacter(response, newRequest)
+
+	result := controllerModel.FindCharacterRequest{}
+	json.NewDecoder(response.Body).Decode(&result)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.NotNil(t, result.HomeworldPlanet)
+	assert.EqualValues(t, "Tatooine", result.HomeworldPlanet.Name)
+	findPlanetControllerMock.AssertExpectations(t)
+
+}
+
+func TestStarWarController_FindStarWarCharacterInvalidExpand(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1?expand=films",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.FindStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+
+}
+
+func TestStarWarController_CreateStarWarCharacterWithLinks(t *testing.T) {
+
+	body := `{"name": "Darth Ezequiel", "height": "202", "mass": "136", "birth_year": "41.9BBY",
+		"films": ["https://swapi.dev/api/films/1/", 2, {"id": 3}],
+		"starships": [{"url": "https://swapi.dev/api/starships/13/"}]}`
+
+	newRequest := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/starwar/characters",
+		bytes.NewBufferString(body),
+	)
+
+	response := httptest.NewRecorder()
+
+	createControllerMock := CreateCharacterControllerMock{}
+	createControllerMock.On("CreateCharacter", mock.IsType(&model.Character{}), controllerContext(newRequest)).
+		Return(&CreateCharacterIdentifier, nil)
+
+	controller := NewStarWarController(&createControllerMock, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.CreateStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+
+	createdCharacter := createControllerMock.Calls[0].Arguments.Get(0).(*model.Character)
+	assert.Equal(t, []model.ResourceLink{{Url: "https://swapi.dev/api/films/1/"}, {Id: 2}, {Id: 3}}, createdCharacter.Films)
+	assert.Equal(t, []model.ResourceLink{{Url: "https://swapi.dev/api/starships/13/"}}, createdCharacter.Starships)
+	assert.Nil(t, createdCharacter.Species)
+}
+
+func TestStarWarController_CreateStarWarCharacterInvalidLink(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/starwar/characters",
+		bytes.NewBufferString(`{"name": "Darth Ezequiel", "films": [true]}`),
+	)
+
+	response := httptest.NewRecorder()
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.CreateStarWarCharacter(response, newRequest)
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+}
+
+func TestStarWarController_FindStarWarCharacterLinks(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	linkedCharacter := character
+	linkedCharacter.Films = []model.ResourceLink{{Id: 1, Url: "https://swapi.dev/api/films/1/"}}
+
+	findCharacterControllerMock := FindCharacterControllerMock{}
+	findCharacterControllerMock.On("FindCharacter", mock.IsType(&model.CharacterIdentifier{}), controllerContext(newRequest)).
+		Return(&model.CharacterDetail{Id: &CreateCharacterIdentifier, Character: &linkedCharacter, Version: 1}, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.FindStarWarCharacter(response, newRequest)
+
+	result := controllerModel.FindCharacterRequest{}
+	json.NewDecoder(response.Body).Decode(&result)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.Equal(t, []controllerModel.LinkResponse{{Id: 1, Url: "https://swapi.dev/api/films/1/"}}, result.Films)
+	assert.Equal(t, []controllerModel.LinkResponse{}, result.Species)
+}
+
+func TestStarWarController_FindStarWarCharacterFields(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1?fields=id,name,height",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	findCharacterControllerMock := FindCharacterControllerMock{}
+
+	findCharacterControllerMock.On("FindCharacterProjection", mock.IsType(&model.CharacterIdentifier{}), mock.MatchedBy(func(p *model.CharacterProjection) bool {
+		return len(p.Fields) == 3 && p.Includes("name") && !p.Includes("mass")
+	}), controllerContext(newRequest)).
+		Return(&CharacterDetail, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.FindStarWarCharacter(response, newRequest)
+
+	body := map[string]any{}
+	json.NewDecoder(response.Result().Body).Decode(&body)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.Equal(t, map[string]any{"Id": 1.0, "name": "Darth Ezequiel", "height": "202"}, body)
+	assert.Empty(t, response.Result().Header.Get("ETag"))
+}
+
+func TestStarWarController_FindStarWarCharacterUnknownField(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1?fields=name,version",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	findCharacterControllerMock := FindCharacterControllerMock{}
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &findCharacterControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.FindStarWarCharacter(response, newRequest)
+
+	body := new(bytes.Buffer)
+	body.ReadFrom(response.Result().Body)
+
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+	assert.EqualValues(t, `Invalid fields: unknown field "version"`, body.String())
+	findCharacterControllerMock.AssertNotCalled(t, "FindCharacterProjection", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestStarWarController_ListStarWarCharactersFields(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters?fields=name,homeworld",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	listControllerMock := ListCharactersControllerMock{}
+
+	listControllerMock.On("ListCharacters", mock.MatchedBy(func(q *model.CharacterQuery) bool {
+		return q.Projection.Includes("homeworld") && !q.Projection.Includes("films")
+	}), controllerContext(newRequest)).
+		Return(&model.CharacterPage{Characters: []*model.CharacterDetail{&CharacterDetail}, Total: 1, Limit: 10}, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &listControllerMock, &FindPlanetControllerMock{})
+
+	controller.ListStarWarCharacters(response, newRequest)
+
+	body := map[string]any{}
+	json.NewDecoder(response.Result().Body).Decode(&body)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, 1, body["count"])
+	assert.Equal(t, []any{map[string]any{"name": "Darth Ezequiel", "homeworld": "https://swapi.dev/api/planets/1/"}}, body["results"])
+}
+
+// controllerContext matches the context the controller derives from the request to hold its span.
+func controllerContext(r *http.Request) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx != r.Context() && ctx.Done() == r.Context().Done()
+	})
+}
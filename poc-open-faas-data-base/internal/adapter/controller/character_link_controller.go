@@ -0,0 +1,89 @@
+package controller
+
+import (
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+)
+
+// writeLinkedCharacters serves /{resource}/{id}/characters for every resource linked to characters.
+func writeLinkedCharacters(w http.ResponseWriter, r *http.Request, kind model.LinkKind, listLinkedCharacters in.ListLinkedCharacters) {
+	ctx := r.Context()
+
+	resourceId, pathError := getParentPathId(r)
+
+	if pathError != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Invalid " + string(kind) + " identifier"))
+		return
+	}
+
+	projection, projectionError := getProjection(r)
+
+	if projectionError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(projectionError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	link, linkError := getCharacterLink(r, kind, resourceId)
+
+	if linkError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(linkError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	listResult, err := listLinkedCharacters.ListLinkedCharacters(link, ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	projectedResponse, projectionError := controllerModel.ProjectResponse(controllerModel.ListResponseFromDomain(listResult), projection)
+	if projectionError != nil {
+		log.Printf("Error converting to json %s", projectionError.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(projectionError.Error()))
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, projectedResponse)
+}
+
+// getCharacterLink reads the page of linked characters, e.g. ?limit=10&offset=0
+func getCharacterLink(r *http.Request, kind model.LinkKind, resourceId int) (*model.CharacterLink, error) {
+	values := r.URL.Query()
+	link := &model.CharacterLink{Kind: kind, Id: resourceId}
+
+	var err error
+
+	if link.Limit, err = getIntParam(values, "limit"); err != nil {
+		return nil, err
+	}
+
+	if link.Offset, err = getIntParam(values, "offset"); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// getParentCharacterIdentifier reads the character of /characters/{id}/{resource}.
+func getParentCharacterIdentifier(r *http.Request) (*model.CharacterIdentifier, error) {
+	pathParam, pathError := getParentPathId(r)
+
+	if pathError != nil {
+		return nil, pathError
+	}
+
+	return &model.CharacterIdentifier{Id: pathParam}, nil
+}
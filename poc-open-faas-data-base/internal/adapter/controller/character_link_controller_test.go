@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type ListLinkedCharactersControllerMock struct {
+	mock.Mock
+}
+
+func (l *ListLinkedCharactersControllerMock) ListLinkedCharacters(
+	link *model.CharacterLink,
+	ctx context.Context) (*model.CharacterPage, error) {
+
+	args := l.Called(link, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.CharacterPage), nil
+}
+
+func TestWriteLinkedCharactersNotFound(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/films/9/characters", nil)
+	response := httptest.NewRecorder()
+
+	linkedMock := ListLinkedCharactersControllerMock{}
+	linkedMock.On("ListLinkedCharacters", &model.CharacterLink{Kind: model.LinkFilms, Id: 9}, newRequest.Context()).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Film Not Found: 9"})
+
+	writeLinkedCharacters(response, newRequest, model.LinkFilms, &linkedMock)
+
+	assert.EqualValues(t, http.StatusNotFound, response.Result().StatusCode)
+}
+
+func TestWriteLinkedCharactersInvalidIdentifier(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/films/a/characters", nil)
+	response := httptest.NewRecorder()
+
+	linkedMock := ListLinkedCharactersControllerMock{}
+
+	writeLinkedCharacters(response, newRequest, model.LinkFilms, &linkedMock)
+
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+	linkedMock.AssertNotCalled(t, "ListLinkedCharacters", mock.Anything, mock.Anything)
+}
+
+func TestWriteLinkedCharactersPage(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/films/1/characters?limit=1&offset=2", nil)
+	response := httptest.NewRecorder()
+
+	linkedMock := ListLinkedCharactersControllerMock{}
+	linkedMock.On("ListLinkedCharacters", &model.CharacterLink{Kind: model.LinkFilms, Id: 1, Limit: 1, Offset: 2}, newRequest.Context()).
+		Return(&model.CharacterPage{Characters: []*model.CharacterDetail{&CharacterDetail}, Total: 5, Limit: 1, Offset: 2}, nil)
+
+	writeLinkedCharacters(response, newRequest, model.LinkFilms, &linkedMock)
+
+	result := controllerModel.ListCharactersResponse{}
+	json.NewDecoder(response.Body).Decode(&result)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, 5, result.Count)
+	assert.EqualValues(t, 1, result.Limit)
+	assert.EqualValues(t, 2, result.Offset)
+	assert.Len(t, result.Results, 1)
+}
+
+func TestWriteLinkedCharactersInvalidPage(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/films/1/characters?limit=-1", nil)
+	response := httptest.NewRecorder()
+
+	linkedMock := ListLinkedCharactersControllerMock{}
+
+	writeLinkedCharacters(response, newRequest, model.LinkFilms, &linkedMock)
+
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+	linkedMock.AssertNotCalled(t, "ListLinkedCharacters", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,177 @@
+package controller
+
+import (
+	"fmt"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// getCharacterQuery reads the listing filters, e.g. ?min_height=150&max_mass=80&born_from=50BBY&born_to=0ABY&sort=-height,name&limit=10&offset=0
+// and the filter expression, e.g. ?filter=gender eq "female" and height gt 170, and the fields, e.g. ?fields=name,height
+func getCharacterQuery(r *http.Request) (*model.CharacterQuery, error) {
+	values := r.URL.Query()
+	query := &model.CharacterQuery{}
+
+	var err error
+
+	if query.Height, err = getRangeFilter(values, "min_height", "max_height"); err != nil {
+		return nil, err
+	}
+
+	if query.Mass, err = getRangeFilter(values, "min_mass", "max_mass"); err != nil {
+		return nil, err
+	}
+
+	if query.BirthYear, err = getBirthYearFilter(values, "born_from", "born_to"); err != nil {
+		return nil, err
+	}
+
+	if query.Filter, err = getFilter(values.Get("filter")); err != nil {
+		return nil, err
+	}
+
+	if query.Projection, err = getProjection(r); err != nil {
+		return nil, err
+	}
+
+	if query.Sort, err = getSortOrders(values.Get("sort")); err != nil {
+		return nil, err
+	}
+
+	if query.Limit, err = getIntParam(values, "limit"); err != nil {
+		return nil, err
+	}
+
+	if query.Offset, err = getIntParam(values, "offset"); err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+func getRangeFilter(values url.Values, minParam string, maxParam string) (model.RangeFilter, error) {
+	min, err := getFloatParam(values, minParam)
+	if err != nil {
+		return model.RangeFilter{}, err
+	}
+
+	max, err := getFloatParam(values, maxParam)
+	if err != nil {
+		return model.RangeFilter{}, err
+	}
+
+	return model.RangeFilter{Min: min, Max: max}, nil
+}
+
+func getBirthYearFilter(values url.Values, fromParam string, toParam string) (model.RangeFilter, error) {
+	from, err := getBirthYearParam(values, fromParam)
+	if err != nil {
+		return model.RangeFilter{}, err
+	}
+
+	to, err := getBirthYearParam(values, toParam)
+	if err != nil {
+		return model.RangeFilter{}, err
+	}
+
+	return model.RangeFilter{Min: from, Max: to}, nil
+}
+
+func getBirthYearParam(values url.Values, name string) (*float64, error) {
+	value := values.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	birthYear, err := model.ParseBirthYear(value)
+	if err != nil || !birthYear.Known() {
+		return nil, badRequest(fmt.Sprintf("Invalid %s, expected a year followed by BBY or ABY: %s", name, value))
+	}
+
+	return birthYear.Year, nil
+}
+
+func getFloatParam(values url.Values, name string) (*float64, error) {
+	value := values.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return nil, badRequest(fmt.Sprintf("Invalid %s: %s", name, value))
+	}
+
+	return &number, nil
+}
+
+func getIntParam(values url.Values, name string) (int, error) {
+	value := values.Get(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 0 {
+		return 0, badRequest(fmt.Sprintf("Invalid %s: %s", name, value))
+	}
+
+	return number, nil
+}
+
+// getProjection reads ?fields=name,height,homeworld, unknown fields are a bad request.
+func getProjection(r *http.Request) (*model.CharacterProjection, error) {
+	projection, err := model.ParseCharacterProjection(r.URL.Query().Get("fields"))
+	if err != nil {
+		return nil, badRequest(fmt.Sprintf("Invalid fields: %s", err.Error()))
+	}
+
+	return projection, nil
+}
+
+func getFilter(filter string) (model.FilterExpression, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+
+	expression, err := model.ParseFilter(filter)
+	if err != nil {
+		return nil, badRequest(fmt.Sprintf("Invalid filter at %s", err.Error()))
+	}
+
+	return expression, nil
+}
+
+// getSortOrders parses "-height,name", a leading minus sorts descending.
+func getSortOrders(sort string) ([]model.SortOrder, error) {
+	if sort == "" {
+		return nil, nil
+	}
+
+	sortOrders := []model.SortOrder{}
+	for _, field := range strings.Split(sort, ",") {
+		field = strings.TrimSpace(field)
+		descending := strings.HasPrefix(field, "-")
+		field = strings.TrimPrefix(field, "-")
+
+		if !model.CharacterSortFields[field] {
+			return nil, badRequest(fmt.Sprintf("Invalid sort field: %s", field))
+		}
+
+		sortOrders = append(sortOrders, model.SortOrder{Field: field, Descending: descending})
+	}
+
+	return sortOrders, nil
+}
+
+func badRequest(msj string) error {
+	return pkg.GenericException{
+		StatusCode: http.StatusBadRequest,
+		Msj:        msj,
+	}
+}
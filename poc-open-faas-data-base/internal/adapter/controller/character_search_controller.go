@@ -0,0 +1,79 @@
+package controller
+
+import (
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+)
+
+type CharacterSearchController struct {
+	searchCharacters in.SearchCharacters
+}
+
+func NewCharacterSearchController(searchCharacters in.SearchCharacters) *CharacterSearchController {
+	return &CharacterSearchController{
+		searchCharacters: searchCharacters,
+	}
+}
+
+// getCharacterSearch reads ?q=skywlker&limit=10&offset=0, ?fields= narrows the response only.
+func getCharacterSearch(r *http.Request) (*model.CharacterSearch, error) {
+	values := r.URL.Query()
+	search := &model.CharacterSearch{Text: values.Get("q")}
+
+	var err error
+
+	if search.Limit, err = getIntParam(values, "limit"); err != nil {
+		return nil, err
+	}
+
+	if search.Offset, err = getIntParam(values, "offset"); err != nil {
+		return nil, err
+	}
+
+	return search, nil
+}
+
+func (c *CharacterSearchController) SearchStarWarCharacters(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	search, searchError := getCharacterSearch(r)
+
+	if searchError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(searchError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	projection, projectionError := getProjection(r)
+
+	if projectionError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(projectionError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	searchResult, err := c.searchCharacters.SearchCharacters(search, ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	projectedResponse, projectionError := controllerModel.ProjectResponse(controllerModel.SearchResponseFromDomain(searchResult), projection)
+	if projectionError != nil {
+		log.Printf("Error converting to json %s", projectionError.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(projectionError.Error()))
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, projectedResponse)
+}
@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type SearchCharactersControllerMock struct {
+	mock.Mock
+}
+
+func (s *SearchCharactersControllerMock) SearchCharacters(
+	search *model.CharacterSearch,
+	ctx context.Context) (*model.CharacterSearchResult, error) {
+
+	args := s.Called(search, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	searchResult := firstParameter.(*model.CharacterSearchResult)
+
+	return searchResult, nil
+
+}
+
+func TestCharacterSearchController_SearchStarWarCharacters(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/search?q=ezekiel&limit=5",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	searchControllerMock := SearchCharactersControllerMock{}
+
+	searchControllerMock.On("SearchCharacters", mock.MatchedBy(func(s *model.CharacterSearch) bool {
+		return s.Text == "ezekiel" && s.Limit == 5 && s.Offset == 0
+	}), newRequest.Context()).
+		Return(&model.CharacterSearchResult{
+			Matches: []*model.CharacterMatch{{
+				Character:  &CharacterDetail,
+				Score:      0.53,
+				Highlights: map[string]string{"name": "Darth <em>Ezequiel</em>"},
+			}},
+			Total: 1,
+			Limit: 5,
+		}, nil)
+
+	controller := NewCharacterSearchController(&searchControllerMock)
+
+	controller.SearchStarWarCharacters(response, newRequest)
+
+	searchResponse := controllerModel.SearchCharactersResponse{}
+	json.NewDecoder(response.Result().Body).Decode(&searchResponse)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, 1, searchResponse.Count)
+	assert.EqualValues(t, "Darth Ezequiel", searchResponse.Results[0].Name)
+	assert.EqualValues(t, 0.53, searchResponse.Results[0].Score)
+	assert.EqualValues(t, "Darth <em>Ezequiel</em>", searchResponse.Results[0].Highlights["name"])
+}
+
+func TestCharacterSearchController_SearchStarWarCharactersInvalidLimit(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/search?q=luke&limit=many",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	searchControllerMock := SearchCharactersControllerMock{}
+
+	controller := NewCharacterSearchController(&searchControllerMock)
+
+	controller.SearchStarWarCharacters(response, newRequest)
+
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+	searchControllerMock.AssertNotCalled(t, "SearchCharacters", mock.Anything, mock.Anything)
+}
+
+func TestCharacterSearchController_SearchStarWarCharactersError(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/search?q=l",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	searchControllerMock := SearchCharactersControllerMock{}
+
+	searchControllerMock.On("SearchCharacters", mock.IsType(&model.CharacterSearch{}), newRequest.Context()).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusBadRequest, Msj: "The search needs at least 2 characters\n"})
+
+	controller := NewCharacterSearchController(&searchControllerMock)
+
+	controller.SearchStarWarCharacters(response, newRequest)
+
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+}
+
+func TestCharacterSearchController_SearchStarWarCharactersFields(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/search?q=ezekiel&fields=name",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	searchControllerMock := SearchCharactersControllerMock{}
+
+	searchControllerMock.On("SearchCharacters", mock.IsType(&model.CharacterSearch{}), newRequest.Context()).
+		Return(&model.CharacterSearchResult{
+			Matches: []*model.CharacterMatch{{
+				Character:  &CharacterDetail,
+				Score:      0.5,
+				Highlights: map[string]string{"name": "Darth <em>Ezequiel</em>"},
+			}},
+			Total: 1,
+		}, nil)
+
+	controller := NewCharacterSearchController(&searchControllerMock)
+
+	controller.SearchStarWarCharacters(response, newRequest)
+
+	body := map[string]any{}
+	json.NewDecoder(response.Result().Body).Decode(&body)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.Equal(t, []any{map[string]any{
+		"name":       "Darth Ezequiel",
+		"score":      0.5,
+		"highlights": map[string]any{"name": "Darth <em>Ezequiel</em>"},
+	}}, body["results"])
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/pkg"
+	"net/http"
+)
+
+type CharacterStatsController struct {
+	findCharacterStats in.FindCharacterStats
+}
+
+func NewCharacterStatsController(findCharacterStats in.FindCharacterStats) *CharacterStatsController {
+	return &CharacterStatsController{
+		findCharacterStats: findCharacterStats,
+	}
+}
+
+// FindCharacterStats accepts the filters of the listing, sort, limit and offset are ignored.
+func (c *CharacterStatsController) FindCharacterStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	characterQuery, queryError := getCharacterQuery(r)
+
+	if queryError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(queryError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	stats, err := c.findCharacterStats.FindCharacterStats(characterQuery, ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, controllerModel.StatsResponseFromDomain(stats))
+}
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type FindCharacterStatsControllerMock struct {
+	mock.Mock
+}
+
+func (f *FindCharacterStatsControllerMock) FindCharacterStats(
+	query *model.CharacterQuery,
+	ctx context.Context) (*model.CharacterStats, error) {
+
+	args := f.Called(query, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	stats := firstParameter.(*model.CharacterStats)
+
+	return stats, nil
+
+}
+
+func TestCharacterStatsController_FindCharacterStats(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/stats?min_height=150&filter="+url.QueryEscape(`gender eq "male"`),
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	statsControllerMock := FindCharacterStatsControllerMock{}
+
+	statsControllerMock.On("FindCharacterStats", mock.MatchedBy(func(q *model.CharacterQuery) bool {
+		return *q.Height.Min == 150 && q.Filter == model.FilterComparison{Field: "gender", Operator: model.FilterEq, Value: "male"}
+	}), newRequest.Context()).
+		Return(&model.CharacterStats{
+			Total:       1,
+			ByGender:    []model.GroupCount{{Value: "male", Count: 1}},
+			ByEyeColor:  []model.GroupCount{{Value: "yellow", Count: 1}},
+			ByHomeworld: []model.GroupCount{},
+			Height:      model.MeasureStats{Min: &characterHeight, Max: &characterHeight, Avg: &characterHeight, Unit: model.HeightUnit, Samples: 1},
+			Mass:        model.MeasureStats{Unit: model.MassUnit},
+		}, nil)
+
+	controller := NewCharacterStatsController(&statsControllerMock)
+
+	controller.FindCharacterStats(response, newRequest)
+
+	statsResponse := controllerModel.CharacterStatsResponse{}
+	json.NewDecoder(response.Result().Body).Decode(&statsResponse)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, 1, statsResponse.Count)
+	assert.EqualValues(t, "male", statsResponse.ByGender[0].Value)
+	assert.EqualValues(t, 202, *statsResponse.Height.Avg)
+	assert.Nil(t, statsResponse.Mass.Avg)
+}
+
+func TestCharacterStatsController_FindCharacterStatsInvalidFilter(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/stats?filter="+url.QueryEscape(`gender gt "male"`),
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	statsControllerMock := FindCharacterStatsControllerMock{}
+
+	controller := NewCharacterStatsController(&statsControllerMock)
+
+	controller.FindCharacterStats(response, newRequest)
+
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+	statsControllerMock.AssertNotCalled(t, "FindCharacterStats", mock.Anything, mock.Anything)
+}
+
+func TestCharacterStatsController_FindCharacterStatsError(t *testing.T) {
+
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/stats", nil)
+
+	response := httptest.NewRecorder()
+
+	statsControllerMock := FindCharacterStatsControllerMock{}
+
+	statsControllerMock.On("FindCharacterStats", mock.IsType(&model.CharacterQuery{}), newRequest.Context()).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusInternalServerError, Msj: "error accessing to cache"})
+
+	controller := NewCharacterStatsController(&statsControllerMock)
+
+	controller.FindCharacterStats(response, newRequest)
+
+	assert.EqualValues(t, http.StatusInternalServerError, response.Result().StatusCode)
+}
@@ -0,0 +1,138 @@
+package codec
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrNotAcceptable is returned by an encoder that cannot represent a response, e.g. csv of a single resource.
+var ErrNotAcceptable = errors.New("the response has no representation in the requested media type")
+
+// ErrUnsupported is returned by a codec that only encodes, e.g. csv request bodies.
+var ErrUnsupported = errors.New("request bodies are not accepted in this media type")
+
+// Codec encodes responses and decodes request bodies of one media type. Every codec but json
+// works with the json field names of the controller models, so all the representations match.
+type Codec interface {
+	// MediaTypes returns the canonical media type first and then its aliases.
+	MediaTypes() []string
+	Encode(w io.Writer, response any) error
+	Decode(r io.Reader, request any) error
+}
+
+type Registry struct {
+	codecs      []Codec
+	byMediaType map[string]Codec
+}
+
+// NewRegistry registers the codecs, the first one answers when the client has no preference.
+func NewRegistry(codecs ...Codec) *Registry {
+	registry := &Registry{codecs: codecs, byMediaType: map[string]Codec{}}
+
+	for _, c := range codecs {
+		for _, mediaType := range c.MediaTypes() {
+			registry.byMediaType[mediaType] = c
+		}
+	}
+
+	return registry
+}
+
+func DefaultRegistry() *Registry {
+	return NewRegistry(JsonCodec{}, XmlCodec{}, CsvCodec{}, MsgpackCodec{}, YamlCodec{})
+}
+
+func (r *Registry) Default() Codec {
+	return r.codecs[0]
+}
+
+// MediaTypes lists the canonical media type of every codec.
+func (r *Registry) MediaTypes() []string {
+	mediaTypes := make([]string, 0, len(r.codecs))
+	for _, c := range r.codecs {
+		mediaTypes = append(mediaTypes, c.MediaTypes()[0])
+	}
+	return mediaTypes
+}
+
+type acceptRange struct {
+	mediaType string
+	quality   float64
+}
+
+// Negotiate picks the codec of the Accept header following the quality values,
+// an empty header or */* gets the default codec.
+func (r *Registry) Negotiate(accept string) (Codec, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return r.Default(), true
+	}
+
+	ranges := []acceptRange{}
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+
+		if quality > 0 {
+			ranges = append(ranges, acceptRange{mediaType: mediaType, quality: quality})
+		}
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	for _, acceptRange := range ranges {
+		if c, ok := r.match(acceptRange.mediaType); ok {
+			return c, true
+		}
+	}
+
+	return nil, false
+}
+
+func (r *Registry) match(mediaType string) (Codec, bool) {
+	if mediaType == "*/*" {
+		return r.Default(), true
+	}
+
+	if strings.HasSuffix(mediaType, "/*") {
+		prefix := strings.TrimSuffix(mediaType, "*")
+		for _, c := range r.codecs {
+			if strings.HasPrefix(c.MediaTypes()[0], prefix) {
+				return c, true
+			}
+		}
+		return nil, false
+	}
+
+	c, ok := r.byMediaType[mediaType]
+	return c, ok
+}
+
+// ForContentType picks the codec of a request body, a body without Content-Type is read with the default codec.
+func (r *Registry) ForContentType(contentType string) (Codec, bool) {
+	if strings.TrimSpace(contentType) == "" {
+		return r.Default(), true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, false
+	}
+
+	c, ok := r.byMediaType[mediaType]
+	return c, ok
+}
@@ -0,0 +1,62 @@
+package codec
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRegistry_Negotiate(t *testing.T) {
+
+	type testCase struct {
+		name      string
+		accept    string
+		mediaType string
+		ok        bool
+	}
+
+	testCases := []testCase{
+		{name: "no header", accept: "", mediaType: "application/json", ok: true},
+		{name: "any", accept: "*/*", mediaType: "application/json", ok: true},
+		{name: "xml", accept: "application/xml", mediaType: "application/xml", ok: true},
+		{name: "alias", accept: "text/xml", mediaType: "application/xml", ok: true},
+		{name: "parameters", accept: "application/yaml; charset=utf-8", mediaType: "application/yaml", ok: true},
+		{name: "quality", accept: "application/xml;q=0.5, application/msgpack", mediaType: "application/msgpack", ok: true},
+		{name: "unknown first", accept: "application/pdf, text/csv;q=0.9", mediaType: "text/csv", ok: true},
+		{name: "type wildcard", accept: "text/*", mediaType: "text/csv", ok: true},
+		{name: "refused", accept: "application/json;q=0, application/xml", mediaType: "application/xml", ok: true},
+		{name: "not acceptable", accept: "application/pdf", ok: false},
+		{name: "only refused", accept: "*/*;q=0", ok: false},
+	}
+
+	registry := DefaultRegistry()
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			c, ok := registry.Negotiate(test.accept)
+
+			assert.EqualValues(t, test.ok, ok)
+			if test.ok {
+				assert.EqualValues(t, test.mediaType, c.MediaTypes()[0])
+			}
+		})
+	}
+}
+
+func TestRegistry_ForContentType(t *testing.T) {
+
+	registry := DefaultRegistry()
+
+	c, ok := registry.ForContentType("")
+	assert.True(t, ok)
+	assert.EqualValues(t, "application/json", c.MediaTypes()[0])
+
+	c, ok = registry.ForContentType("application/x-yaml; charset=utf-8")
+	assert.True(t, ok)
+	assert.EqualValues(t, "application/yaml", c.MediaTypes()[0])
+
+	_, ok = registry.ForContentType("application/pdf")
+	assert.False(t, ok)
+
+	_, ok = registry.ForContentType("not a media type;;")
+	assert.False(t, ok)
+}
@@ -0,0 +1,120 @@
+package codec
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+const csvRows = "results"
+
+// csvFormulaPrefixes start a formula in a spreadsheet, a text cell starting with one of them is
+// written after a ' so it is shown as text, following the OWASP guidance on CSV injection.
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// CsvCodec only writes listings, one row per entry of "results" with a column per key.
+// Nested values such as links are written as compact json.
+type CsvCodec struct{}
+
+func (CsvCodec) MediaTypes() []string {
+	return []string{"text/csv"}
+}
+
+func (CsvCodec) Encode(w io.Writer, response any) error {
+	tree, err := toTree(response)
+	if err != nil {
+		return err
+	}
+
+	rows, ok := csvResults(tree)
+	if !ok {
+		return ErrNotAcceptable
+	}
+
+	columns := []string{}
+	seen := map[string]bool{}
+	for _, row := range rows {
+		for _, f := range row {
+			if !seen[f.key] {
+				seen[f.key] = true
+				columns = append(columns, f.key)
+			}
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		cells := make(map[string]string, len(row))
+		for _, f := range row {
+			cell, err := csvCell(f.value)
+			if err != nil {
+				return err
+			}
+			cells[f.key] = cell
+		}
+
+		record := make([]string, 0, len(columns))
+		for _, column := range columns {
+			record = append(record, cells[column])
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func csvResults(tree any) ([]object, bool) {
+	response, ok := tree.(object)
+	if !ok {
+		return nil, false
+	}
+
+	for _, f := range response {
+		if f.key != csvRows {
+			continue
+		}
+
+		items, ok := f.value.([]any)
+		if !ok {
+			return nil, false
+		}
+
+		rows := make([]object, 0, len(items))
+		for _, item := range items {
+			row, ok := item.(object)
+			if !ok {
+				return nil, false
+			}
+			rows = append(rows, row)
+		}
+		return rows, true
+	}
+
+	return nil, false
+}
+
+func csvCell(value any) (string, error) {
+	switch v := value.(type) {
+	case object, []any:
+		body, err := json.Marshal(value)
+		return string(body), err
+	case string:
+		if v != "" && strings.ContainsRune(csvFormulaPrefixes, rune(v[0])) {
+			return "'" + v, nil
+		}
+	}
+	return scalarText(value), nil
+}
+
+func (CsvCodec) Decode(io.Reader, any) error {
+	return ErrUnsupported
+}
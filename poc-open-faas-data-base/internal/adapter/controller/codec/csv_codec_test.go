@@ -0,0 +1,68 @@
+package codec
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestCsvCodec_Encode(t *testing.T) {
+
+	mass := 77
+	body := &bytes.Buffer{}
+
+	err := CsvCodec{}.Encode(body, &testListing{
+		Count: 2,
+		Results: []*testCharacter{
+			{Name: "Luke Skywalker", Height: "172", Mass: &mass, Films: []string{"film-1"}},
+			{Name: "Leia, Princess", Height: "150", Films: []string{}},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.EqualValues(t,
+		"name,height,mass,films\n"+
+			"Luke Skywalker,172,77,\"[\"\"film-1\"\"]\"\n"+
+			"\"Leia, Princess\",150,,[]\n",
+		body.String())
+}
+
+func TestCsvCodec_EncodeFormula(t *testing.T) {
+
+	mass := -1
+	body := &bytes.Buffer{}
+
+	err := CsvCodec{}.Encode(body, &testListing{
+		Count: 4,
+		Results: []*testCharacter{
+			{Name: "=HYPERLINK(\"https://evil.example.com\")", Height: "+1", Mass: &mass},
+			{Name: "-2+3", Height: "@SUM(A1)"},
+			{Name: "\tcmd", Height: "\rcmd"},
+			{Name: "Luke=Skywalker", Height: "1-2"},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.EqualValues(t,
+		"name,height,mass,films\n"+
+			"\"'=HYPERLINK(\"\"https://evil.example.com\"\")\",'+1,-1,\n"+
+			"'-2+3,'@SUM(A1),,\n"+
+			"'\tcmd,\"'\rcmd\",,\n"+
+			"Luke=Skywalker,1-2,,\n",
+		body.String())
+}
+
+func TestCsvCodec_EncodeNotListing(t *testing.T) {
+
+	err := CsvCodec{}.Encode(&bytes.Buffer{}, &testCharacter{Name: "Luke Skywalker"})
+
+	assert.ErrorIs(t, err, ErrNotAcceptable)
+}
+
+func TestCsvCodec_Decode(t *testing.T) {
+
+	err := CsvCodec{}.Decode(strings.NewReader("name\nLuke"), &testCharacter{})
+
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
@@ -0,0 +1,135 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var jsonUnmarshaler = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// decodeTree fills the request from a decoded xml, yaml or msgpack document. The values are
+// shaped after the field types and then go through encoding/json, so the requests keep their
+// json tags and their own UnmarshalJSON whatever the format of the body.
+func decodeTree(tree any, request any) error {
+	target := reflect.TypeOf(request)
+	if target == nil || target.Kind() != reflect.Ptr {
+		return fmt.Errorf("cannot decode into %T", request)
+	}
+
+	body, err := json.Marshal(shape(target.Elem(), tree))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, request)
+}
+
+// shape converts the scalars a format cannot type, xml text above all, into what the field expects.
+func shape(t reflect.Type, value any) any {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if text, ok := value.(string); ok && reflect.PtrTo(t).Implements(jsonUnmarshaler) {
+		if _, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return json.Number(text)
+		}
+		return text
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		fields := toFields(value)
+		if fields == nil {
+			return value
+		}
+
+		shaped := make(map[string]any, len(fields))
+		for key, fieldValue := range fields {
+			shaped[key] = fieldValue
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := jsonName(field)
+			if fieldValue, ok := fields[name]; ok && name != "" {
+				shaped[name] = shape(field.Type, fieldValue)
+			}
+		}
+		return shaped
+
+	case reflect.Slice, reflect.Array:
+		if text, ok := value.(string); ok && text == "" {
+			return []any{}
+		}
+
+		items, ok := value.([]any)
+		if !ok {
+			items = []any{value}
+		}
+
+		shaped := make([]any, 0, len(items))
+		for _, item := range items {
+			shaped = append(shaped, shape(t.Elem(), item))
+		}
+		return shaped
+
+	case reflect.String:
+		switch value.(type) {
+		case nil, string:
+			return value
+		}
+		return fmt.Sprint(value)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if text, ok := value.(string); ok {
+			if text == "" {
+				return nil
+			}
+			if _, err := strconv.ParseFloat(text, 64); err == nil {
+				return json.Number(text)
+			}
+		}
+
+	case reflect.Bool:
+		if text, ok := value.(string); ok {
+			if parsed, err := strconv.ParseBool(text); err == nil {
+				return parsed
+			}
+		}
+	}
+
+	return value
+}
+
+func toFields(value any) map[string]any {
+	switch v := value.(type) {
+	case map[string]any:
+		return v
+	case map[any]any:
+		fields := make(map[string]any, len(v))
+		for key, fieldValue := range v {
+			fields[fmt.Sprint(key)] = fieldValue
+		}
+		return fields
+	}
+	return nil
+}
+
+func jsonName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+
+	if name := strings.Split(tag, ",")[0]; name != "" {
+		return name
+	}
+
+	return field.Name
+}
@@ -0,0 +1,26 @@
+package codec
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type JsonCodec struct{}
+
+func (JsonCodec) MediaTypes() []string {
+	return []string{"application/json"}
+}
+
+func (JsonCodec) Encode(w io.Writer, response any) error {
+	body, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(body)
+	return err
+}
+
+func (JsonCodec) Decode(r io.Reader, request any) error {
+	return json.NewDecoder(r).Decode(request)
+}
@@ -0,0 +1,83 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type MsgpackCodec struct{}
+
+func (MsgpackCodec) MediaTypes() []string {
+	return []string{"application/msgpack", "application/x-msgpack", "application/vnd.msgpack"}
+}
+
+func (MsgpackCodec) Encode(w io.Writer, response any) error {
+	tree, err := toTree(response)
+	if err != nil {
+		return err
+	}
+
+	return encodeMsgpack(msgpack.NewEncoder(w), tree)
+}
+
+func encodeMsgpack(encoder *msgpack.Encoder, value any) error {
+	switch v := value.(type) {
+	case object:
+		if err := encoder.EncodeMapLen(len(v)); err != nil {
+			return err
+		}
+		for _, f := range v {
+			if err := encoder.EncodeString(f.key); err != nil {
+				return err
+			}
+			if err := encodeMsgpack(encoder, f.value); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case []any:
+		if err := encoder.EncodeArrayLen(len(v)); err != nil {
+			return err
+		}
+		for _, item := range v {
+			if err := encodeMsgpack(encoder, item); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case json.Number:
+		if integer, err := v.Int64(); err == nil {
+			return encoder.EncodeInt(integer)
+		}
+		float, err := v.Float64()
+		if err != nil {
+			return err
+		}
+		return encoder.EncodeFloat64(float)
+
+	case string:
+		return encoder.EncodeString(v)
+
+	case bool:
+		return encoder.EncodeBool(v)
+
+	case nil:
+		return encoder.EncodeNil()
+	}
+
+	return fmt.Errorf("unexpected value %T", value)
+}
+
+func (MsgpackCodec) Decode(r io.Reader, request any) error {
+	var tree any
+	if err := msgpack.NewDecoder(r).Decode(&tree); err != nil {
+		return err
+	}
+
+	return decodeTree(tree, request)
+}
@@ -0,0 +1,26 @@
+package codec
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack/v5"
+	"testing"
+)
+
+func TestMsgpackCodec_RoundTrip(t *testing.T) {
+
+	mass := 77
+	body := &bytes.Buffer{}
+
+	err := MsgpackCodec{}.Encode(body, &testCharacter{Name: "Luke Skywalker", Height: "172", Mass: &mass, Films: []string{"film-1"}})
+	assert.Nil(t, err)
+
+	decoded := map[string]any{}
+	assert.Nil(t, msgpack.Unmarshal(body.Bytes(), &decoded))
+	assert.EqualValues(t, "172", decoded["height"])
+	assert.EqualValues(t, 77, decoded["mass"])
+
+	character := &testCharacter{}
+	assert.Nil(t, MsgpackCodec{}.Decode(bytes.NewReader(body.Bytes()), character))
+	assert.EqualValues(t, &testCharacter{Name: "Luke Skywalker", Height: "172", Mass: &mass, Films: []string{"film-1"}}, character)
+}
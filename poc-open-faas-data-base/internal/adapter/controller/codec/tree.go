@@ -0,0 +1,111 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// field keeps a key of a json object, object keeps them in the order they were marshalled.
+type field struct {
+	key   string
+	value any
+}
+
+type object []field
+
+// toTree marshals the response as json and reads it back keeping the order of the keys.
+// The tree holds object, []any, string, json.Number, bool and nil values.
+func toTree(response any) (any, error) {
+	body, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	return readTree(decoder)
+}
+
+func readTree(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		tree := object{}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := readTree(decoder)
+			if err != nil {
+				return nil, err
+			}
+
+			tree = append(tree, field{key: key.(string), value: value})
+		}
+		_, err := decoder.Token()
+		return tree, err
+
+	case json.Delim('['):
+		items := []any{}
+		for decoder.More() {
+			item, err := readTree(decoder)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		_, err := decoder.Token()
+		return items, err
+	}
+
+	return token, nil
+}
+
+// MarshalJSON writes the object back in its order, used for the nested values of a csv cell.
+func (o object) MarshalJSON() ([]byte, error) {
+	buffer := bytes.Buffer{}
+	buffer.WriteByte('{')
+
+	for i, f := range o {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+
+		key, err := json.Marshal(f.key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(key)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+	}
+
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// scalarText renders a leaf of the tree, nil is empty.
+func scalarText(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
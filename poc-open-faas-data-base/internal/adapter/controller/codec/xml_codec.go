@@ -0,0 +1,144 @@
+package codec
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+const (
+	xmlRoot = "response"
+	xmlItem = "item"
+)
+
+// XmlCodec writes the response inside a <response> element, every json key is an element
+// and the entries of an array are <item> elements.
+type XmlCodec struct{}
+
+func (XmlCodec) MediaTypes() []string {
+	return []string{"application/xml", "text/xml"}
+}
+
+func (XmlCodec) Encode(w io.Writer, response any) error {
+	tree, err := toTree(response)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	if err := encodeXmlElement(encoder, xmlRoot, tree); err != nil {
+		return err
+	}
+
+	return encoder.Flush()
+}
+
+func encodeXmlElement(encoder *xml.Encoder, name string, value any) error {
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if err := encoder.EncodeToken(start); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case object:
+		for _, f := range v {
+			if err := encodeXmlElement(encoder, f.key, f.value); err != nil {
+				return err
+			}
+		}
+
+	case []any:
+		for _, item := range v {
+			if err := encodeXmlElement(encoder, xmlItem, item); err != nil {
+				return err
+			}
+		}
+
+	default:
+		if text := scalarText(v); text != "" {
+			if err := encoder.EncodeToken(xml.CharData(text)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return encoder.EncodeToken(start.End())
+}
+
+// Decode reads the request the way Encode writes it, elements whose children are all <item> are arrays.
+func (XmlCodec) Decode(r io.Reader, request any) error {
+	decoder := xml.NewDecoder(r)
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				return errors.New("empty xml document")
+			}
+			return err
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			tree, err := decodeXmlElement(decoder, start)
+			if err != nil {
+				return err
+			}
+			return decodeTree(tree, request)
+		}
+	}
+}
+
+func decodeXmlElement(decoder *xml.Decoder, start xml.StartElement) (any, error) {
+	text := strings.Builder{}
+	names := []string{}
+	children := []any{}
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			child, err := decodeXmlElement(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, t.Name.Local)
+			children = append(children, child)
+
+		case xml.CharData:
+			text.Write(t)
+
+		case xml.EndElement:
+			if len(children) == 0 {
+				return strings.TrimSpace(text.String()), nil
+			}
+
+			if isXmlArray(names) {
+				return children, nil
+			}
+
+			fields := map[string]any{}
+			for i, name := range names {
+				fields[name] = children[i]
+			}
+			return fields, nil
+		}
+	}
+}
+
+func isXmlArray(names []string) bool {
+	for _, name := range names {
+		if name != xmlItem {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package codec
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"strings"
+	"testing"
+)
+
+type testCharacter struct {
+	Name   string   `json:"name"`
+	Height string   `json:"height"`
+	Mass   *int     `json:"mass"`
+	Films  []string `json:"films"`
+}
+
+type testListing struct {
+	Count   int              `json:"count"`
+	Results []*testCharacter `json:"results"`
+}
+
+func TestXmlCodec_Encode(t *testing.T) {
+
+	body := &bytes.Buffer{}
+
+	err := XmlCodec{}.Encode(body, &testListing{
+		Count: 1,
+		Results: []*testCharacter{
+			{Name: "Luke <Skywalker>", Height: "172", Films: []string{"film-1", "film-2"}},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.EqualValues(t,
+		`<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+			`<response><count>1</count><results><item><name>Luke &lt;Skywalker&gt;</name><height>172</height>`+
+			`<mass></mass><films><item>film-1</item><item>film-2</item></films></item></results></response>`,
+		body.String())
+}
+
+func TestXmlCodec_Decode(t *testing.T) {
+
+	request := &controllerModel.CreaterCharacterRequest{}
+
+	err := XmlCodec{}.Decode(strings.NewReader(`<?xml version="1.0"?>
+		<character>
+			<name>Luke Skywalker</name>
+			<height>172</height>
+			<homeworld_id>1</homeworld_id>
+			<films><item>1</item><item>https://swapi.dev/api/films/2/</item></films>
+			<species/>
+		</character>`), request)
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, "Luke Skywalker", request.Name)
+	assert.EqualValues(t, "172", request.Height)
+	assert.EqualValues(t, 1, *request.HomeworldId)
+	assert.EqualValues(t, []controllerModel.LinkRequest{{Id: 1}, {Url: "https://swapi.dev/api/films/2/"}}, request.Films)
+	assert.Empty(t, request.Species)
+}
+
+func TestXmlCodec_DecodeInvalid(t *testing.T) {
+
+	err := XmlCodec{}.Decode(strings.NewReader(`<character><name>Luke`), &controllerModel.CreaterCharacterRequest{})
+
+	assert.NotNil(t, err)
+}
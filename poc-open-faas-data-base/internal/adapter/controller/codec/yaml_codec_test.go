@@ -0,0 +1,43 @@
+package codec
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"strings"
+	"testing"
+)
+
+func TestYamlCodec_Encode(t *testing.T) {
+
+	body := &bytes.Buffer{}
+
+	err := YamlCodec{}.Encode(body, &testCharacter{Name: "Luke Skywalker", Height: "172", Films: []string{"film-1"}})
+
+	assert.Nil(t, err)
+	assert.EqualValues(t,
+		"name: Luke Skywalker\n"+
+			"height: \"172\"\n"+
+			"mass: null\n"+
+			"films:\n"+
+			"  - film-1\n",
+		body.String())
+}
+
+func TestYamlCodec_Decode(t *testing.T) {
+
+	request := &controllerModel.CreaterCharacterRequest{}
+
+	err := YamlCodec{}.Decode(strings.NewReader(
+		"name: Luke Skywalker\n"+
+			"height: 172\n"+
+			"homeworld_id: 1\n"+
+			"films:\n"+
+			"  - 1\n"+
+			"  - url: https://swapi.dev/api/films/2/\n"), request)
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, "172", request.Height)
+	assert.EqualValues(t, 1, *request.HomeworldId)
+	assert.EqualValues(t, []controllerModel.LinkRequest{{Id: 1}, {Url: "https://swapi.dev/api/films/2/"}}, request.Films)
+}
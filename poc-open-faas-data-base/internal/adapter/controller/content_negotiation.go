@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"handler/function/internal/adapter/controller/codec"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+	"strings"
+)
+
+var codecs = codec.DefaultRegistry()
+
+// Negotiated answers 406 and 415 before the handler runs, so nothing is created or updated
+// for a client that cannot read the response or sent a body in a media type we do not read.
+// csv only represents listings, it is only negotiated for reads.
+func Negotiated(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		responseCodec, ok := codecs.Negotiate(r.Header.Get("Accept"))
+
+		if !ok || (r.Method != http.MethodGet && isCsv(responseCodec)) {
+			w.WriteHeader(http.StatusNotAcceptable)
+			w.Write([]byte(fmt.Sprintf("Not acceptable, supported media types: %s", strings.Join(codecs.MediaTypes(), ", "))))
+			return
+		}
+
+		if hasBody(r) {
+			if requestCodec, ok := codecs.ForContentType(r.Header.Get("Content-Type")); !ok || isCsv(requestCodec) {
+				w.WriteHeader(http.StatusUnsupportedMediaType)
+				w.Write([]byte(fmt.Sprintf("Unsupported media type %q", r.Header.Get("Content-Type"))))
+				return
+			}
+		}
+
+		handler(w, r)
+	}
+}
+
+func isCsv(c codec.Codec) bool {
+	_, ok := c.(codec.CsvCodec)
+	return ok
+}
+
+func hasBody(r *http.Request) bool {
+	return (r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch) &&
+		r.Body != nil && r.Body != http.NoBody
+}
+
+// responseCodec falls back to json when a handler is called without Negotiated.
+func responseCodec(r *http.Request) codec.Codec {
+	if c, ok := codecs.Negotiate(r.Header.Get("Accept")); ok {
+		return c
+	}
+	return codecs.Default()
+}
+
+// writeResponse writes the response in the negotiated media type.
+func writeResponse(w http.ResponseWriter, r *http.Request, statusCode int, response any) {
+	responseCodec := responseCodec(r)
+
+	_, span := tracer.Start(r.Context(), "encode response", trace.WithAttributes(attribute.String("http.response.media_type", responseCodec.MediaTypes()[0])))
+	body := &bytes.Buffer{}
+	encodeError := responseCodec.Encode(body, response)
+	span.End()
+
+	if errors.Is(encodeError, codec.ErrNotAcceptable) {
+		w.WriteHeader(http.StatusNotAcceptable)
+		w.Write([]byte(fmt.Sprintf("Not acceptable, %s is only available for listings", responseCodec.MediaTypes()[0])))
+		return
+	}
+
+	if encodeError != nil {
+		log.Printf("Error converting to %s %s", responseCodec.MediaTypes()[0], encodeError.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(encodeError.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", responseCodec.MediaTypes()[0])
+	w.Header().Add("Vary", "Accept")
+	w.WriteHeader(statusCode)
+	w.Write(body.Bytes())
+}
+
+// decodeRequest reads the body in the media type of its Content-Type.
+func decodeRequest(r *http.Request, request any) *pkg.GenericException {
+	requestCodec, ok := codecs.ForContentType(r.Header.Get("Content-Type"))
+
+	if !ok {
+		return &pkg.GenericException{
+			Msj:        fmt.Sprintf("Unsupported media type %q", r.Header.Get("Content-Type")),
+			StatusCode: http.StatusUnsupportedMediaType,
+		}
+	}
+
+	_, span := tracer.Start(r.Context(), "decode request", trace.WithAttributes(attribute.String("http.request.media_type", requestCodec.MediaTypes()[0])))
+	err := requestCodec.Decode(r.Body, request)
+	span.End()
+
+	if errors.Is(err, codec.ErrUnsupported) {
+		return &pkg.GenericException{
+			Msj:        fmt.Sprintf("Unsupported media type %q", r.Header.Get("Content-Type")),
+			StatusCode: http.StatusUnsupportedMediaType,
+		}
+	}
+
+	if err != nil {
+		log.Printf("Error reading request body: %s", err.Error())
+		return &pkg.GenericException{
+			Msj:        fmt.Sprintf("Invalid request body: %s", err.Error()),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+
+	return nil
+}
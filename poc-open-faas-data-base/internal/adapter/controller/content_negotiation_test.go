@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNegotiated(t *testing.T) {
+
+	type testCase struct {
+		name        string
+		method      string
+		accept      string
+		contentType string
+		body        string
+		statusCode  int
+	}
+
+	testCases := []testCase{
+		{name: "default", method: http.MethodGet, statusCode: http.StatusOK},
+		{name: "xml", method: http.MethodGet, accept: "application/xml", statusCode: http.StatusOK},
+		{name: "csv read", method: http.MethodGet, accept: "text/csv", statusCode: http.StatusOK},
+		{name: "not acceptable", method: http.MethodGet, accept: "application/pdf", statusCode: http.StatusNotAcceptable},
+		{name: "csv write", method: http.MethodPost, accept: "text/csv", body: "{}", statusCode: http.StatusNotAcceptable},
+		{name: "yaml body", method: http.MethodPost, contentType: "application/yaml", body: "name: Luke", statusCode: http.StatusOK},
+		{name: "csv body", method: http.MethodPost, contentType: "text/csv", body: "name\nLuke", statusCode: http.StatusUnsupportedMediaType},
+		{name: "unsupported body", method: http.MethodPut, contentType: "application/pdf", body: "%PDF", statusCode: http.StatusUnsupportedMediaType},
+		{name: "delete", method: http.MethodDelete, contentType: "application/pdf", statusCode: http.StatusOK},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			var body io.Reader
+			if test.body != "" {
+				body = strings.NewReader(test.body)
+			}
+
+			newRequest := httptest.NewRequest(test.method, "/api/v1/starwar/characters", body)
+			newRequest.Header.Set("Accept", test.accept)
+			newRequest.Header.Set("Content-Type", test.contentType)
+
+			response := httptest.NewRecorder()
+
+			Negotiated(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})(response, newRequest)
+
+			assert.EqualValues(t, test.statusCode, response.Result().StatusCode)
+		})
+	}
+}
+
+func TestStarWarController_ListStarWarCharactersCsv(t *testing.T) {
+
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters?fields=name,height", nil)
+	newRequest.Header.Set("Accept", "text/csv")
+
+	response := httptest.NewRecorder()
+
+	listControllerMock := ListCharactersControllerMock{}
+
+	listControllerMock.On("ListCharacters", mock.IsType(&model.CharacterQuery{}), controllerContext(newRequest)).
+		Return(&model.CharacterPage{Characters: []*model.CharacterDetail{&CharacterDetail}, Total: 1, Limit: 10}, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &listControllerMock, &FindPlanetControllerMock{})
+
+	controller.ListStarWarCharacters(response, newRequest)
+
+	body, _ := io.ReadAll(response.Result().Body)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, "text/csv", response.Result().Header.Get("Content-Type"))
+	assert.EqualValues(t, "height,name\n202,Darth Ezequiel\n", string(body))
+}
+
+func TestStarWarController_FindStarWarCharacterCsv(t *testing.T) {
+
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/4", nil)
+	newRequest.Header.Set("Accept", "text/csv")
+
+	response := httptest.NewRecorder()
+
+	findControllerMock := FindCharacterControllerMock{}
+
+	findControllerMock.On("FindCharacter", &model.CharacterIdentifier{Id: 4}, controllerContext(newRequest)).
+		Return(&CharacterDetail, nil)
+
+	controller := NewStarWarController(&CreateCharacterControllerMock{}, &findControllerMock, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.FindStarWarCharacter(response, newRequest)
+
+	assert.EqualValues(t, http.StatusNotAcceptable, response.Result().StatusCode)
+}
+
+func TestStarWarController_CreateStarWarCharacterXml(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/starwar/characters",
+		strings.NewReader(`<character><name>Darth Ezequiel</name><height>202</height><mass>136</mass>`+
+			`<birth_year>41.9BBY</birth_year><films><item>1</item></films></character>`),
+	)
+	newRequest.Header.Set("Content-Type", "application/xml")
+	newRequest.Header.Set("Accept", "application/xml")
+
+	response := httptest.NewRecorder()
+
+	createControllerMock := CreateCharacterControllerMock{}
+
+	createControllerMock.On("CreateCharacter", mock.MatchedBy(func(c *model.Character) bool {
+		return c.Name == "Darth Ezequiel" && len(c.Films) == 1
+	}), controllerContext(newRequest)).
+		Return(&CreateCharacterIdentifier, nil)
+
+	controller := NewStarWarController(&createControllerMock, &FindCharacterControllerMock{}, &UpdateCharacterControllerMock{}, &ListCharactersControllerMock{}, &FindPlanetControllerMock{})
+
+	controller.CreateStarWarCharacter(response, newRequest)
+
+	body, _ := io.ReadAll(response.Result().Body)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, "application/xml", response.Result().Header.Get("Content-Type"))
+	assert.True(t, strings.HasPrefix(string(body), `<?xml version="1.0" encoding="UTF-8"?>`+"\n<response>"))
+}
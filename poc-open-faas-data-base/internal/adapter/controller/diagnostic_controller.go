@@ -0,0 +1,33 @@
+package controller
+
+import (
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/pkg"
+	"net/http"
+)
+
+type DiagnosticController struct {
+	findDiagnostic in.FindDiagnostic
+}
+
+func NewDiagnosticController(findDiagnostic in.FindDiagnostic) *DiagnosticController {
+	return &DiagnosticController{
+		findDiagnostic: findDiagnostic,
+	}
+}
+
+func (c *DiagnosticController) FindDatabaseStatistics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	statistics, err := c.findDiagnostic.FindDatabaseStatistics(ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, controllerModel.DatabaseStatisticsResponseFromDomain(statistics))
+}
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var databaseStatistics = model.DatabaseStatistics{
+	AcquiredConns:     1,
+	IdleConns:         3,
+	TotalConns:        4,
+	MaxConns:          10,
+	AcquireCount:      25,
+	AcquireDuration:   time.Duration(150) * time.Millisecond,
+	EmptyAcquireCount: 2,
+}
+
+type FindDiagnosticControllerMock struct {
+	mock.Mock
+}
+
+func (f *FindDiagnosticControllerMock) FindDatabaseStatistics(ctx context.Context) (*model.DatabaseStatistics, error) {
+	args := f.Called(ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	statistics := firstParameter.(*model.DatabaseStatistics)
+
+	return statistics, nil
+}
+
+func TestDiagnosticController_FindDatabaseStatistics(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/diagnostics/database",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	findDiagnosticMock := FindDiagnosticControllerMock{}
+	findDiagnosticMock.On("FindDatabaseStatistics", newRequest.Context()).
+		Return(&databaseStatistics, nil)
+
+	controller := NewDiagnosticController(&findDiagnosticMock)
+
+	controller.FindDatabaseStatistics(response, newRequest)
+
+	statisticsResponse := controllerModel.DatabaseStatisticsResponse{}
+	json.NewDecoder(response.Result().Body).Decode(&statisticsResponse)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, 150, statisticsResponse.AcquireDurationMillis)
+	assert.EqualValues(t, 2, statisticsResponse.EmptyAcquireCount)
+}
+
+func TestDiagnosticController_FindDatabaseStatisticsError(t *testing.T) {
+
+	newRequest := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/diagnostics/database",
+		nil,
+	)
+
+	response := httptest.NewRecorder()
+
+	findDiagnosticMock := FindDiagnosticControllerMock{}
+	findDiagnosticMock.On("FindDatabaseStatistics", newRequest.Context()).
+		Return(nil, fmt.Errorf("generic error"))
+
+	controller := NewDiagnosticController(&findDiagnosticMock)
+
+	controller.FindDatabaseStatistics(response, newRequest)
+	assert.EqualValues(t, http.StatusInternalServerError, response.Result().StatusCode)
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"fmt"
+	"handler/function/internal/application/model"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func formatETag(version int) string {
+	return fmt.Sprintf("\"%d\"", version)
+}
+
+// parseETag reads a strong validator, the version is the only thing compared.
+func parseETag(etag string) (int, bool) {
+	etag = strings.TrimSpace(etag)
+
+	if len(etag) < 2 || !strings.HasPrefix(etag, "\"") || !strings.HasSuffix(etag, "\"") {
+		return 0, false
+	}
+
+	version, err := strconv.Atoi(etag[1 : len(etag)-1])
+	if err != nil || version == model.AnyVersion {
+		return 0, false
+	}
+
+	return version, true
+}
+
+// getIfMatchVersion compares strongly as If-Match requires, a weak validator never matches.
+// If-Match: * is model.AnyVersion.
+func getIfMatchVersion(r *http.Request) (int, bool) {
+	ifMatch := strings.TrimSpace(r.Header.Get("If-Match"))
+	if ifMatch == "*" {
+		return model.AnyVersion, true
+	}
+
+	return parseETag(ifMatch)
+}
+
+// matchIfNoneMatch compares weakly as If-None-Match allows, so W/ validators match too.
+func matchIfNoneMatch(r *http.Request, version int) bool {
+	ifNoneMatch := r.Header.Get("If-None-Match")
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	for _, etag := range strings.Split(ifNoneMatch, ",") {
+		etag = strings.TrimSpace(etag)
+		if etag == "*" {
+			return true
+		}
+
+		etagVersion, ok := parseETag(strings.TrimPrefix(etag, "W/"))
+		if ok && etagVersion == version {
+			return true
+		}
+	}
+
+	return false
+}
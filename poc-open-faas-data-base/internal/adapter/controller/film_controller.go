@@ -0,0 +1,27 @@
+package controller
+
+import (
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+)
+
+// FilmController serves /films and /characters/{id}/films.
+type FilmController = LinkedResourceController[*model.Film, *model.FilmIdentifier, *model.FilmDetail]
+
+func NewFilmController(
+	createFilm in.CreateLinkedResource[*model.Film, *model.FilmIdentifier],
+	findFilm in.FindLinkedResource[*model.FilmIdentifier, *model.FilmDetail],
+	listCharacterFilms in.ListCharacterLinkedResources[*model.FilmDetail],
+	listLinkedCharacters in.ListLinkedCharacters,
+) *FilmController {
+	return newLinkedResourceController(linkedResourceMapping[*model.Film, *model.FilmIdentifier, *model.FilmDetail]{
+		name:           "film",
+		kind:           model.LinkFilms,
+		toDomain:       decodeLinkedResource(controllerModel.CreateFilmRequest.ToDomain),
+		identifier:     func(id int) *model.FilmIdentifier { return &model.FilmIdentifier{Id: id} },
+		createResponse: asResponse(controllerModel.CreateFilmResponseFromDomain),
+		findResponse:   asResponse(controllerModel.FindFilmResponseFromDomain),
+		listResponse:   asResponse(controllerModel.ListFilmsResponseFromDomain),
+	}, createFilm, findFilm, listCharacterFilms, listLinkedCharacters)
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/pkg"
+	"net/http"
+)
+
+type HealthController struct {
+	checkReadiness in.CheckReadiness
+}
+
+func NewHealthController(checkReadiness in.CheckReadiness) *HealthController {
+	return &HealthController{
+		checkReadiness: checkReadiness,
+	}
+}
+
+// Liveness only tells the process answers, a dependency that is down must not get the function restarted.
+func (c *HealthController) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, r, http.StatusOK, &controllerModel.LivenessResponse{Status: "alive"})
+}
+
+// Readiness answers 503 until the function is initialized and while a dependency is down, so it gets no traffic.
+func (c *HealthController) Readiness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	readiness, err := c.checkReadiness.CheckReadiness(ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	statusCode := http.StatusOK
+	if !readiness.Ready() {
+		statusCode = http.StatusServiceUnavailable
+	}
+
+	writeResponse(w, r, statusCode, controllerModel.ReadinessResponseFromDomain(readiness))
+}
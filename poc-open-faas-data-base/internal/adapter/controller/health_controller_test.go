@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type CheckReadinessControllerMock struct {
+	mock.Mock
+}
+
+func (c *CheckReadinessControllerMock) CheckReadiness(ctx context.Context) (*model.Readiness, error) {
+	args := c.Called(ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.Readiness), nil
+}
+
+func TestHealthController_Liveness(t *testing.T) {
+
+	newRequest := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+
+	response := httptest.NewRecorder()
+
+	controller := NewHealthController(&CheckReadinessControllerMock{})
+
+	controller.Liveness(response, newRequest)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.JSONEq(t, `{"status": "alive"}`, response.Body.String())
+}
+
+func TestHealthController_Readiness(t *testing.T) {
+
+	type testCase struct {
+		name       string
+		readiness  *model.Readiness
+		statusCode int
+		body       string
+	}
+
+	testCases := []testCase{
+		{
+			name: "ready",
+			readiness: &model.Readiness{Initialized: true, Dependencies: []model.DependencyHealth{
+				{Name: "cache", Status: model.DependencyUp, Latency: 1500 * time.Microsecond},
+				{Name: "database", Status: model.DependencyUp, Latency: 3 * time.Millisecond},
+			}},
+			statusCode: http.StatusOK,
+			body: `{"status": "ready", "initialized": true, "dependencies": [
+				{"name": "cache", "status": "up", "latency_ms": 1.5},
+				{"name": "database", "status": "up", "latency_ms": 3}
+			]}`,
+		},
+		{
+			name: "dependency down",
+			readiness: &model.Readiness{Initialized: true, Dependencies: []model.DependencyHealth{
+				{Name: "cache", Status: model.DependencyDown, Latency: 2 * time.Second, Error: "context deadline exceeded"},
+				{Name: "database", Status: model.DependencyUp, Latency: 3 * time.Millisecond},
+			}},
+			statusCode: http.StatusServiceUnavailable,
+			body: `{"status": "degraded", "initialized": true, "dependencies": [
+				{"name": "cache", "status": "down", "latency_ms": 2000, "error": "context deadline exceeded"},
+				{"name": "database", "status": "up", "latency_ms": 3}
+			]}`,
+		},
+		{
+			name:       "not initialized",
+			readiness:  &model.Readiness{},
+			statusCode: http.StatusServiceUnavailable,
+			body:       `{"status": "degraded", "initialized": false, "dependencies": []}`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			newRequest := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+
+			response := httptest.NewRecorder()
+
+			checkReadinessMock := CheckReadinessControllerMock{}
+			checkReadinessMock.On("CheckReadiness", newRequest.Context()).Return(test.readiness, nil)
+
+			controller := NewHealthController(&checkReadinessMock)
+
+			controller.Readiness(response, newRequest)
+
+			assert.EqualValues(t, test.statusCode, response.Result().StatusCode)
+			assert.EqualValues(t, "application/json", response.Result().Header.Get("Content-Type"))
+			assert.JSONEq(t, test.body, response.Body.String())
+		})
+	}
+}
+
+func TestHealthController_ReadinessError(t *testing.T) {
+
+	newRequest := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+
+	response := httptest.NewRecorder()
+
+	checkReadinessMock := CheckReadinessControllerMock{}
+	checkReadinessMock.On("CheckReadiness", newRequest.Context()).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusInternalServerError, Msj: "generic error"})
+
+	controller := NewHealthController(&checkReadinessMock)
+
+	controller.Readiness(response, newRequest)
+
+	assert.EqualValues(t, http.StatusInternalServerError, response.Result().StatusCode)
+	assert.EqualValues(t, "generic error", response.Body.String())
+}
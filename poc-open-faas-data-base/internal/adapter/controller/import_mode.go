@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+)
+
+// importTokenHeader carries the token that allows a create in import mode.
+const importTokenHeader = "X-Import-Token"
+
+type importAuthorizedKey struct{}
+
+// ImportAuthorized marks the requests that send token in the X-Import-Token header, only they
+// keep the client timestamps with ?mode=import. An empty token authorizes no request.
+func ImportAuthorized(token string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authorized := token != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get(importTokenHeader)), []byte(token)) == 1
+
+		handler(w, r.WithContext(context.WithValue(r.Context(), importAuthorizedKey{}, authorized)))
+	}
+}
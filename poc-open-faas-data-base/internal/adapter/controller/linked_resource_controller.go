@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/pkg"
+	"net/http"
+)
+
+// linkedResourceMapping is all a linked resource controller knows of its resource: its name,
+// the relation it has with characters and how it converts from requests and to responses.
+type linkedResourceMapping[R any, I any, D any] struct {
+	name           string
+	kind           model.LinkKind
+	toDomain       func(r *http.Request) (R, error)
+	identifier     func(id int) I
+	createResponse func(identifier I) any
+	findResponse   func(detail D) any
+	listResponse   func(details []D) any
+}
+
+// LinkedResourceController serves the films, species, vehicles and starships, R is the resource,
+// I its identifier and D its detail.
+type LinkedResourceController[R any, I any, D any] struct {
+	mapping                linkedResourceMapping[R, I, D]
+	createResource         in.CreateLinkedResource[R, I]
+	findResource           in.FindLinkedResource[I, D]
+	listCharacterResources in.ListCharacterLinkedResources[D]
+	listLinkedCharacters   in.ListLinkedCharacters
+}
+
+func newLinkedResourceController[R any, I any, D any](
+	mapping linkedResourceMapping[R, I, D],
+	createResource in.CreateLinkedResource[R, I],
+	findResource in.FindLinkedResource[I, D],
+	listCharacterResources in.ListCharacterLinkedResources[D],
+	listLinkedCharacters in.ListLinkedCharacters,
+) *LinkedResourceController[R, I, D] {
+	return &LinkedResourceController[R, I, D]{
+		mapping:                mapping,
+		createResource:         createResource,
+		findResource:           findResource,
+		listCharacterResources: listCharacterResources,
+		listLinkedCharacters:   listLinkedCharacters,
+	}
+}
+
+// decodeLinkedResource reads the request body of a resource, created and edited are only kept in import mode.
+func decodeLinkedResource[Req any, R any](toDomain func(request Req, importMode bool) (R, error)) func(r *http.Request) (R, error) {
+	return func(r *http.Request) (R, error) {
+		requestBody := new(Req)
+
+		if err := decodeRequest(r, requestBody); err != nil {
+			var resource R
+			return resource, *err
+		}
+
+		return toDomain(*requestBody, isImportMode(r))
+	}
+}
+
+// asResponse lets the typed response mappings of a resource fill linkedResourceMapping.
+func asResponse[T any, Res any](toResponse func(T) Res) func(T) any {
+	return func(t T) any {
+		return toResponse(t)
+	}
+}
+
+func (c *LinkedResourceController[R, I, D]) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resource, requestError := c.mapping.toDomain(r)
+
+	if requestError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(requestError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	createResult, err := c.createResource.Create(resource, ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, c.mapping.createResponse(createResult))
+}
+
+func (c *LinkedResourceController[R, I, D]) Find(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pathParam, pathError := getPathId(r)
+
+	if pathError != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Invalid " + c.mapping.name + " identifier"))
+		return
+	}
+
+	findResult, err := c.findResource.Find(c.mapping.identifier(pathParam), ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, c.mapping.findResponse(findResult))
+}
+
+// ListCharacterResources serves /characters/{id}/{resource}.
+func (c *LinkedResourceController[R, I, D]) ListCharacterResources(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	characterIdentifier, pathError := getParentCharacterIdentifier(r)
+
+	if pathError != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Invalid character identifier"))
+		return
+	}
+
+	listResult, err := c.listCharacterResources.ListByCharacter(characterIdentifier, ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, c.mapping.listResponse(listResult))
+}
+
+// ListResourceCharacters serves /{resource}/{id}/characters.
+func (c *LinkedResourceController[R, I, D]) ListResourceCharacters(w http.ResponseWriter, r *http.Request) {
+	writeLinkedCharacters(w, r, c.mapping.kind, c.listLinkedCharacters)
+}
@@ -0,0 +1,229 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var filmDetail = model.FilmDetail{
+	Id:   &model.FilmIdentifier{Id: 1},
+	Film: &model.Film{Title: "A New Hope", Url: "https://swapi.dev/api/films/1/"},
+}
+
+type LinkedResourceUseCaseMock[R any, I any, D any] struct {
+	mock.Mock
+}
+
+func (m *LinkedResourceUseCaseMock[R, I, D]) Create(resource R, ctx context.Context) (I, error) {
+	args := m.Called(resource, ctx)
+	identifier, _ := args.Get(0).(I)
+	return identifier, args.Error(1)
+}
+
+func (m *LinkedResourceUseCaseMock[R, I, D]) Find(identifier I, ctx context.Context) (D, error) {
+	args := m.Called(identifier, ctx)
+	detail, _ := args.Get(0).(D)
+	return detail, args.Error(1)
+}
+
+func (m *LinkedResourceUseCaseMock[R, I, D]) ListByCharacter(character *model.CharacterIdentifier, ctx context.Context) ([]D, error) {
+	args := m.Called(character, ctx)
+	details, _ := args.Get(0).([]D)
+	return details, args.Error(1)
+}
+
+type FilmUseCaseMock = LinkedResourceUseCaseMock[*model.Film, *model.FilmIdentifier, *model.FilmDetail]
+
+func TestLinkedResourceController_Create(t *testing.T) {
+
+	type testCase struct {
+		name       string
+		body       string
+		identifier *model.FilmIdentifier
+		err        error
+		statusCode int
+	}
+
+	testCases := []testCase{
+		{name: "created", body: `{"title": "A New Hope", "episode_id": 4}`, identifier: filmDetail.Id, statusCode: http.StatusOK},
+		{name: "invalid body", body: "{", statusCode: http.StatusBadRequest},
+		{name: "duplicated url", body: `{"title": "A New Hope", "url": "https://swapi.dev/api/films/1/"}`, err: pkg.GenericException{StatusCode: http.StatusConflict, Msj: "Film url already exists"}, statusCode: http.StatusConflict},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			newRequest := httptest.NewRequest(http.MethodPost, "/api/v1/starwar/films", bytes.NewBufferString(test.body))
+			response := httptest.NewRecorder()
+
+			useCase := FilmUseCaseMock{}
+			useCase.On("Create", mock.IsType(&model.Film{}), newRequest.Context()).Return(test.identifier, test.err)
+
+			NewFilmController(&useCase, &useCase, &useCase, &ListLinkedCharactersControllerMock{}).Create(response, newRequest)
+
+			assert.EqualValues(t, test.statusCode, response.Result().StatusCode)
+
+			if test.statusCode == http.StatusOK {
+				result := controllerModel.CreateFilmResponse{}
+				json.NewDecoder(response.Body).Decode(&result)
+				assert.EqualValues(t, 1, result.Id)
+			}
+		})
+	}
+}
+
+func TestLinkedResourceController_Find(t *testing.T) {
+
+	type testCase struct {
+		name       string
+		url        string
+		err        error
+		statusCode int
+		body       string
+	}
+
+	testCases := []testCase{
+		{name: "found", url: "/api/v1/starwar/films/1", statusCode: http.StatusOK, body: filmDetail.Film.Title},
+		{name: "not found", url: "/api/v1/starwar/films/1", err: pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Film Not Found"}, statusCode: http.StatusNotFound, body: "Film Not Found"},
+		{name: "invalid identifier", url: "/api/v1/starwar/films/a", statusCode: http.StatusBadRequest, body: "Invalid film identifier"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			newRequest := httptest.NewRequest(http.MethodGet, test.url, nil)
+			response := httptest.NewRecorder()
+
+			useCase := FilmUseCaseMock{}
+			if test.err != nil {
+				useCase.On("Find", filmDetail.Id, newRequest.Context()).Return(nil, test.err)
+			} else {
+				useCase.On("Find", filmDetail.Id, newRequest.Context()).Return(&filmDetail, nil)
+			}
+
+			NewFilmController(&useCase, &useCase, &useCase, &ListLinkedCharactersControllerMock{}).Find(response, newRequest)
+
+			assert.EqualValues(t, test.statusCode, response.Result().StatusCode)
+			assert.Contains(t, response.Body.String(), test.body)
+		})
+	}
+}
+
+func TestLinkedResourceController_ListCharacterResources(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/1/films", nil)
+	response := httptest.NewRecorder()
+
+	useCase := FilmUseCaseMock{}
+	useCase.On("ListByCharacter", &model.CharacterIdentifier{Id: 1}, newRequest.Context()).
+		Return([]*model.FilmDetail{&filmDetail}, nil)
+
+	NewFilmController(&useCase, &useCase, &useCase, &ListLinkedCharactersControllerMock{}).ListCharacterResources(response, newRequest)
+
+	result := controllerModel.ListFilmsResponse{}
+	json.NewDecoder(response.Body).Decode(&result)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, 1, result.Count)
+	assert.EqualValues(t, filmDetail.Film.Url, result.Results[0].Url)
+}
+
+func TestLinkedResourceController_ListResourceCharacters(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/films/1/characters", nil)
+	response := httptest.NewRecorder()
+
+	linkedMock := ListLinkedCharactersControllerMock{}
+	linkedMock.On("ListLinkedCharacters", &model.CharacterLink{Kind: model.LinkFilms, Id: 1}, newRequest.Context()).
+		Return(&model.CharacterPage{Characters: []*model.CharacterDetail{&CharacterDetail}, Total: 1, Limit: model.DefaultPageLimit}, nil)
+
+	useCase := FilmUseCaseMock{}
+
+	NewFilmController(&useCase, &useCase, &useCase, &linkedMock).ListResourceCharacters(response, newRequest)
+
+	result := controllerModel.ListCharactersResponse{}
+	json.NewDecoder(response.Body).Decode(&result)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, 1, result.Count)
+}
+
+// TestLinkedResourceControllers checks the mapping of every resource, the controller itself is tested with films.
+func TestLinkedResourceControllers(t *testing.T) {
+
+	type testCase struct {
+		name     string
+		resource string
+		find     http.HandlerFunc
+		create   http.HandlerFunc
+		body     string
+	}
+
+	testCases := []testCase{
+		{
+			name: "films", resource: "films", body: `{"title": "A New Hope"}`,
+			find:   findWith(NewFilmController, &filmDetail),
+			create: createWith(NewFilmController, &model.Film{Title: "A New Hope"}, &model.FilmIdentifier{Id: 1}),
+		},
+		{
+			name: "species", resource: "species", body: `{"name": "Wookie"}`,
+			find:   findWith(NewSpeciesController, &model.SpeciesDetail{Id: &model.SpeciesIdentifier{Id: 1}, Species: &model.Species{Name: "Wookie", Url: "https://swapi.dev/api/species/3/"}}),
+			create: createWith(NewSpeciesController, &model.Species{Name: "Wookie"}, &model.SpeciesIdentifier{Id: 1}),
+		},
+		{
+			name: "vehicles", resource: "vehicles", body: `{"name": "Sand Crawler"}`,
+			find:   findWith(NewVehicleController, &model.VehicleDetail{Id: &model.VehicleIdentifier{Id: 1}, Vehicle: &model.Vehicle{Name: "Sand Crawler", Url: "https://swapi.dev/api/vehicles/4/"}}),
+			create: createWith(NewVehicleController, &model.Vehicle{Name: "Sand Crawler"}, &model.VehicleIdentifier{Id: 1}),
+		},
+		{
+			name: "starships", resource: "starships", body: `{"name": "Death Star"}`,
+			find:   findWith(NewStarshipController, &model.StarshipDetail{Id: &model.StarshipIdentifier{Id: 1}, Starship: &model.Starship{Name: "Death Star", Url: "https://swapi.dev/api/starships/9/"}}),
+			create: createWith(NewStarshipController, &model.Starship{Name: "Death Star"}, &model.StarshipIdentifier{Id: 1}),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			response := httptest.NewRecorder()
+			test.find(response, httptest.NewRequest(http.MethodGet, "/api/v1/starwar/"+test.resource+"/1", nil))
+
+			assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+			assert.Contains(t, response.Body.String(), "https://swapi.dev/api/"+test.resource+"/")
+
+			response = httptest.NewRecorder()
+			test.create(response, httptest.NewRequest(http.MethodPost, "/api/v1/starwar/"+test.resource, bytes.NewBufferString(test.body)))
+
+			assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+			assert.JSONEq(t, `{"id": 1}`, response.Body.String())
+		})
+	}
+}
+
+type linkedResourceControllerConstructor[R any, I any, D any] func(
+	in.CreateLinkedResource[R, I],
+	in.FindLinkedResource[I, D],
+	in.ListCharacterLinkedResources[D],
+	in.ListLinkedCharacters,
+) *LinkedResourceController[R, I, D]
+
+// findWith serves the find of a resource that answers detail.
+func findWith[R any, I any, D any](newController linkedResourceControllerConstructor[R, I, D], detail D) http.HandlerFunc {
+	useCase := &LinkedResourceUseCaseMock[R, I, D]{}
+	useCase.On("Find", mock.Anything, mock.Anything).Return(detail, nil)
+
+	return newController(useCase, useCase, useCase, &ListLinkedCharactersControllerMock{}).Find
+}
+
+// createWith serves the create of a resource that expects the request to decode to resource.
+func createWith[R any, I any, D any](newController linkedResourceControllerConstructor[R, I, D], resource R, identifier I) http.HandlerFunc {
+	useCase := &LinkedResourceUseCaseMock[R, I, D]{}
+	useCase.On("Create", resource, mock.Anything).Return(identifier, nil)
+
+	return newController(useCase, useCase, useCase, &ListLinkedCharactersControllerMock{}).Create
+}
@@ -0,0 +1,35 @@
+package model
+
+import "handler/function/internal/application/model"
+
+// CharacterMatchResponse is a character with the relevance of the match and its highlighted fields.
+type CharacterMatchResponse struct {
+	*FindCharacterRequest
+	Score      float64           `json:"score"`
+	Highlights map[string]string `json:"highlights"`
+}
+
+type SearchCharactersResponse struct {
+	Count   int                       `json:"count"`
+	Limit   int                       `json:"limit"`
+	Offset  int                       `json:"offset"`
+	Results []*CharacterMatchResponse `json:"results"`
+}
+
+func SearchResponseFromDomain(r *model.CharacterSearchResult) *SearchCharactersResponse {
+	results := make([]*CharacterMatchResponse, 0, len(r.Matches))
+	for _, match := range r.Matches {
+		results = append(results, &CharacterMatchResponse{
+			FindCharacterRequest: FindResponseFromDomain(match.Character),
+			Score:                match.Score,
+			Highlights:           match.Highlights,
+		})
+	}
+
+	return &SearchCharactersResponse{
+		Count:   r.Total,
+		Limit:   r.Limit,
+		Offset:  r.Offset,
+		Results: results,
+	}
+}
@@ -0,0 +1,45 @@
+package model
+
+import "handler/function/internal/application/model"
+
+type CharacterStatsResponse struct {
+	Count       int                  `json:"count"`
+	ByGender    []GroupCountResponse `json:"by_gender"`
+	ByEyeColor  []GroupCountResponse `json:"by_eye_color"`
+	ByHomeworld []GroupCountResponse `json:"by_homeworld"`
+	Height      MeasureStatsResponse `json:"height"`
+	Mass        MeasureStatsResponse `json:"mass"`
+}
+
+type GroupCountResponse struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// MeasureStatsResponse leaves min, max and avg null when no character has a known value.
+type MeasureStatsResponse struct {
+	Min     *float64 `json:"min"`
+	Max     *float64 `json:"max"`
+	Avg     *float64 `json:"avg"`
+	Unit    string   `json:"unit"`
+	Samples int      `json:"samples"`
+}
+
+func StatsResponseFromDomain(s *model.CharacterStats) *CharacterStatsResponse {
+	return &CharacterStatsResponse{
+		Count:       s.Total,
+		ByGender:    groupCountsFromDomain(s.ByGender),
+		ByEyeColor:  groupCountsFromDomain(s.ByEyeColor),
+		ByHomeworld: groupCountsFromDomain(s.ByHomeworld),
+		Height:      MeasureStatsResponse(s.Height),
+		Mass:        MeasureStatsResponse(s.Mass),
+	}
+}
+
+func groupCountsFromDomain(groupCounts []model.GroupCount) []GroupCountResponse {
+	response := make([]GroupCountResponse, 0, len(groupCounts))
+	for _, groupCount := range groupCounts {
+		response = append(response, GroupCountResponse(groupCount))
+	}
+	return response
+}
@@ -1,37 +1,107 @@
 package model
 
-import "handler/function/internal/application/model"
+import (
+	"fmt"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"time"
+)
 
 type CreaterCharacterRequest struct {
-	Name      string `json:"name"`
-	Height    string `json:"height"`
-	Mass      string `json:"mass"`
-	HairColor string `json:"hair_color"`
-	SkinColor string `json:"skin_color"`
-	EyeColor  string `json:"eye_color"`
-	BirthYear string `json:"birth_year"`
-	Gender    string `json:"gender"`
-	Homeworld string `json:"homeworld"`
-	Created   string `json:"created"`
-	Edited    string `json:"edited"`
-	Url       string `json:"url"`
+	Name        string        `json:"name"`
+	Height      string        `json:"height"`
+	Mass        string        `json:"mass"`
+	HairColor   string        `json:"hair_color"`
+	SkinColor   string        `json:"skin_color"`
+	EyeColor    string        `json:"eye_color"`
+	BirthYear   string        `json:"birth_year"`
+	Gender      string        `json:"gender"`
+	Homeworld   string        `json:"homeworld"`
+	HomeworldId *int          `json:"homeworld_id,omitempty"`
+	Films       []LinkRequest `json:"films"`
+	Species     []LinkRequest `json:"species"`
+	Vehicles    []LinkRequest `json:"vehicles"`
+	Starships   []LinkRequest `json:"starships"`
+	Created     string        `json:"created"`
+	Edited      string        `json:"edited"`
+	Url         string        `json:"url"`
 }
 
-func (r CreaterCharacterRequest) ToDomain() *model.Character {
-	return &model.Character{
-		Name:      r.Name,
-		Height:    r.Height,
-		Mass:      r.Mass,
-		HairColor: r.HairColor,
-		SkinColor: r.SkinColor,
-		EyeColor:  r.EyeColor,
-		BirthYear: r.BirthYear,
-		Gender:    r.Gender,
-		Homeworld: r.Homeworld,
-		Created:   r.Created,
-		Edited:    r.Edited,
-		Url:       r.Url,
+// ToDomain ignores the created and edited timestamps sent by the client, they are managed by the server.
+// Only the import mode keeps them, so characters copied from SWAPI preserve their original timestamps.
+func (r CreaterCharacterRequest) ToDomain(importMode bool) (*model.Character, error) {
+	height, err := model.ParseHeight(r.Height)
+	if err != nil {
+		return nil, pkg.GenericException{StatusCode: http.StatusBadRequest, Msj: err.Error()}
 	}
+
+	mass, err := model.ParseMass(r.Mass)
+	if err != nil {
+		return nil, pkg.GenericException{StatusCode: http.StatusBadRequest, Msj: err.Error()}
+	}
+
+	birthYear, err := model.ParseBirthYear(r.BirthYear)
+	if err != nil {
+		return nil, pkg.GenericException{StatusCode: http.StatusBadRequest, Msj: err.Error()}
+	}
+
+	character := &model.Character{
+		Name:        r.Name,
+		Height:      height,
+		Mass:        mass,
+		HairColor:   r.HairColor,
+		SkinColor:   r.SkinColor,
+		EyeColor:    r.EyeColor,
+		BirthYear:   birthYear,
+		Gender:      r.Gender,
+		Homeworld:   r.Homeworld,
+		HomeworldId: r.HomeworldId,
+		Url:         r.Url,
+		Films:       linksToDomain(r.Films),
+		Species:     linksToDomain(r.Species),
+		Vehicles:    linksToDomain(r.Vehicles),
+		Starships:   linksToDomain(r.Starships),
+	}
+
+	if !importMode {
+		return character, nil
+	}
+
+	created, err := parseTimestamp("created", r.Created)
+	if err != nil {
+		return nil, err
+	}
+
+	edited, err := parseTimestamp("edited", r.Edited)
+	if err != nil {
+		return nil, err
+	}
+
+	character.Created = created
+	character.Edited = edited
+
+	return character, nil
+}
+
+func parseTimestamp(field string, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, pkg.GenericException{
+			StatusCode: http.StatusBadRequest,
+			Msj:        fmt.Sprintf("Invalid %s timestamp, RFC3339 expected: %s", field, value),
+		}
+	}
+
+	return timestamp.UTC(), nil
+}
+
+func formatTimestamp(timestamp time.Time) string {
+	return timestamp.UTC().Format(time.RFC3339Nano)
 }
 
 type CreateCharacterResponse struct {
@@ -39,19 +109,25 @@ type CreateCharacterResponse struct {
 }
 
 type FindCharacterRequest struct {
-	Id        int    `json:"Id"`
-	Name      string `json:"name"`
-	Height    string `json:"height"`
-	Mass      string `json:"mass"`
-	HairColor string `json:"hair_color"`
-	SkinColor string `json:"skin_color"`
-	EyeColor  string `json:"eye_color"`
-	BirthYear string `json:"birth_year"`
-	Gender    string `json:"gender"`
-	Homeworld string `json:"homeworld"`
-	Created   string `json:"created"`
-	Edited    string `json:"edited"`
-	Url       string `json:"url"`
+	Id              int                 `json:"Id"`
+	Name            string              `json:"name"`
+	Height          string              `json:"height"`
+	Mass            string              `json:"mass"`
+	HairColor       string              `json:"hair_color"`
+	SkinColor       string              `json:"skin_color"`
+	EyeColor        string              `json:"eye_color"`
+	BirthYear       string              `json:"birth_year"`
+	Gender          string              `json:"gender"`
+	Homeworld       string              `json:"homeworld"`
+	HomeworldId     *int                `json:"homeworld_id,omitempty"`
+	HomeworldPlanet *FindPlanetResponse `json:"homeworld_planet,omitempty"`
+	Films           []LinkResponse      `json:"films"`
+	Species         []LinkResponse      `json:"species"`
+	Vehicles        []LinkResponse      `json:"vehicles"`
+	Starships       []LinkResponse      `json:"starships"`
+	Created         string              `json:"created"`
+	Edited          string              `json:"edited"`
+	Url             string              `json:"url"`
 }
 
 func CreateResponseFromDomain(c *model.CharacterIdentifier) *CreateCharacterResponse {
@@ -62,18 +138,44 @@ func CreateResponseFromDomain(c *model.CharacterIdentifier) *CreateCharacterResp
 
 func FindResponseFromDomain(c *model.CharacterDetail) *FindCharacterRequest {
 	return &FindCharacterRequest{
-		Id:        c.Id.Id,
-		Name:      c.Character.Name,
-		Height:    c.Character.Height,
-		Mass:      c.Character.Mass,
-		HairColor: c.Character.HairColor,
-		SkinColor: c.Character.SkinColor,
-		EyeColor:  c.Character.EyeColor,
-		BirthYear: c.Character.BirthYear,
-		Gender:    c.Character.Gender,
-		Homeworld: c.Character.Homeworld,
-		Created:   c.Character.Created,
-		Edited:    c.Character.Edited,
-		Url:       c.Character.Url,
+		Id:          c.Id.Id,
+		Name:        c.Character.Name,
+		Height:      c.Character.Height.String(),
+		Mass:        c.Character.Mass.String(),
+		HairColor:   c.Character.HairColor,
+		SkinColor:   c.Character.SkinColor,
+		EyeColor:    c.Character.EyeColor,
+		BirthYear:   c.Character.BirthYear.String(),
+		Gender:      c.Character.Gender,
+		Homeworld:   c.Character.Homeworld,
+		HomeworldId: c.Character.HomeworldId,
+		Films:       linksFromDomain(c.Character.Films),
+		Species:     linksFromDomain(c.Character.Species),
+		Vehicles:    linksFromDomain(c.Character.Vehicles),
+		Starships:   linksFromDomain(c.Character.Starships),
+		Created:     formatTimestamp(c.Character.Created),
+		Edited:      formatTimestamp(c.Character.Edited),
+		Url:         c.Character.Url,
+	}
+}
+
+type ListCharactersResponse struct {
+	Count   int                     `json:"count"`
+	Limit   int                     `json:"limit"`
+	Offset  int                     `json:"offset"`
+	Results []*FindCharacterRequest `json:"results"`
+}
+
+func ListResponseFromDomain(p *model.CharacterPage) *ListCharactersResponse {
+	results := make([]*FindCharacterRequest, 0, len(p.Characters))
+	for _, characterDetail := range p.Characters {
+		results = append(results, FindResponseFromDomain(characterDetail))
+	}
+
+	return &ListCharactersResponse{
+		Count:   p.Total,
+		Limit:   p.Limit,
+		Offset:  p.Offset,
+		Results: results,
 	}
 }
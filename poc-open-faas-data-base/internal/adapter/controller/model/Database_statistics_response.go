@@ -0,0 +1,31 @@
+package model
+
+import "handler/function/internal/application/model"
+
+type DatabaseStatisticsResponse struct {
+	AcquiredConns         int32 `json:"acquired_conns"`
+	IdleConns             int32 `json:"idle_conns"`
+	ConstructingConns     int32 `json:"constructing_conns"`
+	TotalConns            int32 `json:"total_conns"`
+	MaxConns              int32 `json:"max_conns"`
+	AcquireCount          int64 `json:"acquire_count"`
+	AcquireDurationMillis int64 `json:"acquire_duration_ms"`
+	EmptyAcquireCount     int64 `json:"empty_acquire_count"`
+	CanceledAcquireCount  int64 `json:"canceled_acquire_count"`
+	NewConnsCount         int64 `json:"new_conns_count"`
+}
+
+func DatabaseStatisticsResponseFromDomain(s *model.DatabaseStatistics) *DatabaseStatisticsResponse {
+	return &DatabaseStatisticsResponse{
+		AcquiredConns:         s.AcquiredConns,
+		IdleConns:             s.IdleConns,
+		ConstructingConns:     s.ConstructingConns,
+		TotalConns:            s.TotalConns,
+		MaxConns:              s.MaxConns,
+		AcquireCount:          s.AcquireCount,
+		AcquireDurationMillis: s.AcquireDuration.Milliseconds(),
+		EmptyAcquireCount:     s.EmptyAcquireCount,
+		CanceledAcquireCount:  s.CanceledAcquireCount,
+		NewConnsCount:         s.NewConnsCount,
+	}
+}
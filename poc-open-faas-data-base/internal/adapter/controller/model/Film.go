@@ -0,0 +1,102 @@
+package model
+
+import "handler/function/internal/application/model"
+
+type CreateFilmRequest struct {
+	Title        string `json:"title"`
+	EpisodeId    int    `json:"episode_id"`
+	OpeningCrawl string `json:"opening_crawl"`
+	Director     string `json:"director"`
+	Producer     string `json:"producer"`
+	ReleaseDate  string `json:"release_date"`
+	Created      string `json:"created"`
+	Edited       string `json:"edited"`
+	Url          string `json:"url"`
+}
+
+// ToDomain follows the character rules, created and edited are only kept in import mode.
+func (r CreateFilmRequest) ToDomain(importMode bool) (*model.Film, error) {
+	film := &model.Film{
+		Title:        r.Title,
+		EpisodeId:    r.EpisodeId,
+		OpeningCrawl: r.OpeningCrawl,
+		Director:     r.Director,
+		Producer:     r.Producer,
+		ReleaseDate:  r.ReleaseDate,
+		Url:          r.Url,
+	}
+
+	if !importMode {
+		return film, nil
+	}
+
+	created, err := parseTimestamp("created", r.Created)
+	if err != nil {
+		return nil, err
+	}
+
+	edited, err := parseTimestamp("edited", r.Edited)
+	if err != nil {
+		return nil, err
+	}
+
+	film.Created = created
+	film.Edited = edited
+
+	return film, nil
+}
+
+type CreateFilmResponse struct {
+	Id int `json:"id"`
+}
+
+type FindFilmResponse struct {
+	Id           int    `json:"Id"`
+	Title        string `json:"title"`
+	EpisodeId    int    `json:"episode_id"`
+	OpeningCrawl string `json:"opening_crawl"`
+	Director     string `json:"director"`
+	Producer     string `json:"producer"`
+	ReleaseDate  string `json:"release_date"`
+	Created      string `json:"created"`
+	Edited       string `json:"edited"`
+	Url          string `json:"url"`
+}
+
+type ListFilmsResponse struct {
+	Count   int                 `json:"count"`
+	Results []*FindFilmResponse `json:"results"`
+}
+
+func CreateFilmResponseFromDomain(f *model.FilmIdentifier) *CreateFilmResponse {
+	return &CreateFilmResponse{
+		Id: f.Id,
+	}
+}
+
+func FindFilmResponseFromDomain(f *model.FilmDetail) *FindFilmResponse {
+	return &FindFilmResponse{
+		Id:           f.Id.Id,
+		Title:        f.Film.Title,
+		EpisodeId:    f.Film.EpisodeId,
+		OpeningCrawl: f.Film.OpeningCrawl,
+		Director:     f.Film.Director,
+		Producer:     f.Film.Producer,
+		ReleaseDate:  f.Film.ReleaseDate,
+		Created:      formatTimestamp(f.Film.Created),
+		Edited:       formatTimestamp(f.Film.Edited),
+		Url:          f.Film.Url,
+	}
+}
+
+func ListFilmsResponseFromDomain(filmDetails []*model.FilmDetail) *ListFilmsResponse {
+	results := make([]*FindFilmResponse, 0, len(filmDetails))
+	for _, filmDetail := range filmDetails {
+		results = append(results, FindFilmResponseFromDomain(filmDetail))
+	}
+
+	return &ListFilmsResponse{
+		Count:   len(results),
+		Results: results,
+	}
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"handler/function/internal/application/model"
+	"time"
+)
+
+const (
+	statusReady    = "ready"
+	statusDegraded = "degraded"
+)
+
+type LivenessResponse struct {
+	Status string `json:"status"`
+}
+
+type ReadinessResponse struct {
+	Status       string                     `json:"status"`
+	Initialized  bool                       `json:"initialized"`
+	Dependencies []DependencyHealthResponse `json:"dependencies"`
+}
+
+type DependencyHealthResponse struct {
+	Name          string  `json:"name"`
+	Status        string  `json:"status"`
+	LatencyMillis float64 `json:"latency_ms"`
+	Error         string  `json:"error,omitempty"`
+}
+
+func ReadinessResponseFromDomain(r *model.Readiness) *ReadinessResponse {
+	dependencies := make([]DependencyHealthResponse, 0, len(r.Dependencies))
+	for _, dependency := range r.Dependencies {
+		dependencies = append(dependencies, DependencyHealthResponse{
+			Name:          dependency.Name,
+			Status:        dependency.Status,
+			LatencyMillis: float64(dependency.Latency) / float64(time.Millisecond),
+			Error:         dependency.Error,
+		})
+	}
+
+	status := statusReady
+	if !r.Ready() {
+		status = statusDegraded
+	}
+
+	return &ReadinessResponse{
+		Status:       status,
+		Initialized:  r.Initialized,
+		Dependencies: dependencies,
+	}
+}
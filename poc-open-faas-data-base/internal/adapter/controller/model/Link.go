@@ -0,0 +1,68 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"handler/function/internal/application/model"
+)
+
+// LinkRequest accepts a SWAPI url, a numeric id or an {"id": 1, "url": "..."} object,
+// so characters can be imported from SWAPI as is or linked to resources created here.
+type LinkRequest struct {
+	Id  int    `json:"id,omitempty"`
+	Url string `json:"url,omitempty"`
+}
+
+func (l *LinkRequest) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	switch {
+	case len(data) > 0 && data[0] == '"':
+		return json.Unmarshal(data, &l.Url)
+	case len(data) > 0 && data[0] == '{':
+		type linkObject LinkRequest
+		object := linkObject{}
+		if err := json.Unmarshal(data, &object); err != nil {
+			return err
+		}
+		*l = LinkRequest(object)
+	default:
+		if err := json.Unmarshal(data, &l.Id); err != nil {
+			return fmt.Errorf("link must be an url, an id or an object: %s", string(data))
+		}
+	}
+
+	if l.Id == 0 && l.Url == "" {
+		return fmt.Errorf("link must have an url or an id: %s", string(data))
+	}
+
+	return nil
+}
+
+type LinkResponse struct {
+	Id  int    `json:"id"`
+	Url string `json:"url"`
+}
+
+func linksToDomain(links []LinkRequest) []model.ResourceLink {
+	if len(links) == 0 {
+		return nil
+	}
+
+	resourceLinks := make([]model.ResourceLink, 0, len(links))
+	for _, link := range links {
+		resourceLinks = append(resourceLinks, model.ResourceLink{Id: link.Id, Url: link.Url})
+	}
+
+	return resourceLinks
+}
+
+func linksFromDomain(resourceLinks []model.ResourceLink) []LinkResponse {
+	links := make([]LinkResponse, 0, len(resourceLinks))
+	for _, resourceLink := range resourceLinks {
+		links = append(links, LinkResponse{Id: resourceLink.Id, Url: resourceLink.Url})
+	}
+
+	return links
+}
@@ -0,0 +1,97 @@
+package model
+
+import "handler/function/internal/application/model"
+
+type CreatePlanetRequest struct {
+	Name           string `json:"name"`
+	RotationPeriod string `json:"rotation_period"`
+	OrbitalPeriod  string `json:"orbital_period"`
+	Diameter       string `json:"diameter"`
+	Climate        string `json:"climate"`
+	Gravity        string `json:"gravity"`
+	Terrain        string `json:"terrain"`
+	SurfaceWater   string `json:"surface_water"`
+	Population     string `json:"population"`
+	Created        string `json:"created"`
+	Edited         string `json:"edited"`
+	Url            string `json:"url"`
+}
+
+// ToDomain follows the character rules, created and edited are only kept in import mode.
+func (r CreatePlanetRequest) ToDomain(importMode bool) (*model.Planet, error) {
+	planet := &model.Planet{
+		Name:           r.Name,
+		RotationPeriod: r.RotationPeriod,
+		OrbitalPeriod:  r.OrbitalPeriod,
+		Diameter:       r.Diameter,
+		Climate:        r.Climate,
+		Gravity:        r.Gravity,
+		Terrain:        r.Terrain,
+		SurfaceWater:   r.SurfaceWater,
+		Population:     r.Population,
+		Url:            r.Url,
+	}
+
+	if !importMode {
+		return planet, nil
+	}
+
+	created, err := parseTimestamp("created", r.Created)
+	if err != nil {
+		return nil, err
+	}
+
+	edited, err := parseTimestamp("edited", r.Edited)
+	if err != nil {
+		return nil, err
+	}
+
+	planet.Created = created
+	planet.Edited = edited
+
+	return planet, nil
+}
+
+type CreatePlanetResponse struct {
+	Id int `json:"id"`
+}
+
+type FindPlanetResponse struct {
+	Id             int    `json:"Id"`
+	Name           string `json:"name"`
+	RotationPeriod string `json:"rotation_period"`
+	OrbitalPeriod  string `json:"orbital_period"`
+	Diameter       string `json:"diameter"`
+	Climate        string `json:"climate"`
+	Gravity        string `json:"gravity"`
+	Terrain        string `json:"terrain"`
+	SurfaceWater   string `json:"surface_water"`
+	Population     string `json:"population"`
+	Created        string `json:"created"`
+	Edited         string `json:"edited"`
+	Url            string `json:"url"`
+}
+
+func CreatePlanetResponseFromDomain(p *model.PlanetIdentifier) *CreatePlanetResponse {
+	return &CreatePlanetResponse{
+		Id: p.Id,
+	}
+}
+
+func FindPlanetResponseFromDomain(p *model.PlanetDetail) *FindPlanetResponse {
+	return &FindPlanetResponse{
+		Id:             p.Id.Id,
+		Name:           p.Planet.Name,
+		RotationPeriod: p.Planet.RotationPeriod,
+		OrbitalPeriod:  p.Planet.OrbitalPeriod,
+		Diameter:       p.Planet.Diameter,
+		Climate:        p.Planet.Climate,
+		Gravity:        p.Planet.Gravity,
+		Terrain:        p.Planet.Terrain,
+		SurfaceWater:   p.Planet.SurfaceWater,
+		Population:     p.Planet.Population,
+		Created:        formatTimestamp(p.Planet.Created),
+		Edited:         formatTimestamp(p.Planet.Edited),
+		Url:            p.Planet.Url,
+	}
+}
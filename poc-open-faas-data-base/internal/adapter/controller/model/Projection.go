@@ -0,0 +1,63 @@
+package model
+
+import (
+	"encoding/json"
+	"handler/function/internal/application/model"
+	"strings"
+)
+
+// ProjectResponse narrows the characters of a response to the requested fields, the response
+// itself or, for listings, every entry of its results. Keys that are not character fields,
+// such as the count or a search score, are always kept.
+func ProjectResponse(response any, projection *model.CharacterProjection) (any, error) {
+	if projection == nil {
+		return response, nil
+	}
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+
+	results, listing := fields["results"]
+	if !listing {
+		return projectCharacter(fields, projection), nil
+	}
+
+	entries := []map[string]json.RawMessage{}
+	if err := json.Unmarshal(results, &entries); err != nil {
+		return nil, err
+	}
+
+	for i, entry := range entries {
+		entries[i] = projectCharacter(entry, projection)
+	}
+
+	if fields["results"], err = json.Marshal(entries); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// projectCharacter compares keys ignoring case as the id is serialized as "Id",
+// the expanded planet goes along with the homeworld.
+func projectCharacter(fields map[string]json.RawMessage, projection *model.CharacterProjection) map[string]json.RawMessage {
+	for key := range fields {
+		field := strings.ToLower(key)
+		if field == "homeworld_planet" {
+			field = "homeworld"
+		}
+
+		if model.CharacterFields[field] && !projection.Includes(field) {
+			delete(fields, key)
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,118 @@
+package model
+
+import "handler/function/internal/application/model"
+
+type CreateSpeciesRequest struct {
+	Name            string `json:"name"`
+	Classification  string `json:"classification"`
+	Designation     string `json:"designation"`
+	AverageHeight   string `json:"average_height"`
+	SkinColors      string `json:"skin_colors"`
+	HairColors      string `json:"hair_colors"`
+	EyeColors       string `json:"eye_colors"`
+	AverageLifespan string `json:"average_lifespan"`
+	Homeworld       string `json:"homeworld"`
+	Language        string `json:"language"`
+	Created         string `json:"created"`
+	Edited          string `json:"edited"`
+	Url             string `json:"url"`
+}
+
+// ToDomain follows the character rules, created and edited are only kept in import mode.
+func (r CreateSpeciesRequest) ToDomain(importMode bool) (*model.Species, error) {
+	species := &model.Species{
+		Name:            r.Name,
+		Classification:  r.Classification,
+		Designation:     r.Designation,
+		AverageHeight:   r.AverageHeight,
+		SkinColors:      r.SkinColors,
+		HairColors:      r.HairColors,
+		EyeColors:       r.EyeColors,
+		AverageLifespan: r.AverageLifespan,
+		Homeworld:       r.Homeworld,
+		Language:        r.Language,
+		Url:             r.Url,
+	}
+
+	if !importMode {
+		return species, nil
+	}
+
+	created, err := parseTimestamp("created", r.Created)
+	if err != nil {
+		return nil, err
+	}
+
+	edited, err := parseTimestamp("edited", r.Edited)
+	if err != nil {
+		return nil, err
+	}
+
+	species.Created = created
+	species.Edited = edited
+
+	return species, nil
+}
+
+type CreateSpeciesResponse struct {
+	Id int `json:"id"`
+}
+
+type FindSpeciesResponse struct {
+	Id              int    `json:"Id"`
+	Name            string `json:"name"`
+	Classification  string `json:"classification"`
+	Designation     string `json:"designation"`
+	AverageHeight   string `json:"average_height"`
+	SkinColors      string `json:"skin_colors"`
+	HairColors      string `json:"hair_colors"`
+	EyeColors       string `json:"eye_colors"`
+	AverageLifespan string `json:"average_lifespan"`
+	Homeworld       string `json:"homeworld"`
+	Language        string `json:"language"`
+	Created         string `json:"created"`
+	Edited          string `json:"edited"`
+	Url             string `json:"url"`
+}
+
+type ListSpeciesResponse struct {
+	Count   int                    `json:"count"`
+	Results []*FindSpeciesResponse `json:"results"`
+}
+
+func CreateSpeciesResponseFromDomain(s *model.SpeciesIdentifier) *CreateSpeciesResponse {
+	return &CreateSpeciesResponse{
+		Id: s.Id,
+	}
+}
+
+func FindSpeciesResponseFromDomain(s *model.SpeciesDetail) *FindSpeciesResponse {
+	return &FindSpeciesResponse{
+		Id:              s.Id.Id,
+		Name:            s.Species.Name,
+		Classification:  s.Species.Classification,
+		Designation:     s.Species.Designation,
+		AverageHeight:   s.Species.AverageHeight,
+		SkinColors:      s.Species.SkinColors,
+		HairColors:      s.Species.HairColors,
+		EyeColors:       s.Species.EyeColors,
+		AverageLifespan: s.Species.AverageLifespan,
+		Homeworld:       s.Species.Homeworld,
+		Language:        s.Species.Language,
+		Created:         formatTimestamp(s.Species.Created),
+		Edited:          formatTimestamp(s.Species.Edited),
+		Url:             s.Species.Url,
+	}
+}
+
+func ListSpeciesResponseFromDomain(speciesDetails []*model.SpeciesDetail) *ListSpeciesResponse {
+	results := make([]*FindSpeciesResponse, 0, len(speciesDetails))
+	for _, speciesDetail := range speciesDetails {
+		results = append(results, FindSpeciesResponseFromDomain(speciesDetail))
+	}
+
+	return &ListSpeciesResponse{
+		Count:   len(results),
+		Results: results,
+	}
+}
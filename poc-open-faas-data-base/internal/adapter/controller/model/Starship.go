@@ -0,0 +1,130 @@
+package model
+
+import "handler/function/internal/application/model"
+
+type CreateStarshipRequest struct {
+	Name                 string `json:"name"`
+	Model                string `json:"model"`
+	Manufacturer         string `json:"manufacturer"`
+	CostInCredits        string `json:"cost_in_credits"`
+	Length               string `json:"length"`
+	MaxAtmospheringSpeed string `json:"max_atmosphering_speed"`
+	Crew                 string `json:"crew"`
+	Passengers           string `json:"passengers"`
+	CargoCapacity        string `json:"cargo_capacity"`
+	Consumables          string `json:"consumables"`
+	HyperdriveRating     string `json:"hyperdrive_rating"`
+	MGLT                 string `json:"MGLT"`
+	StarshipClass        string `json:"starship_class"`
+	Created              string `json:"created"`
+	Edited               string `json:"edited"`
+	Url                  string `json:"url"`
+}
+
+// ToDomain follows the character rules, created and edited are only kept in import mode.
+func (r CreateStarshipRequest) ToDomain(importMode bool) (*model.Starship, error) {
+	starship := &model.Starship{
+		Name:                 r.Name,
+		Model:                r.Model,
+		Manufacturer:         r.Manufacturer,
+		CostInCredits:        r.CostInCredits,
+		Length:               r.Length,
+		MaxAtmospheringSpeed: r.MaxAtmospheringSpeed,
+		Crew:                 r.Crew,
+		Passengers:           r.Passengers,
+		CargoCapacity:        r.CargoCapacity,
+		Consumables:          r.Consumables,
+		HyperdriveRating:     r.HyperdriveRating,
+		MGLT:                 r.MGLT,
+		StarshipClass:        r.StarshipClass,
+		Url:                  r.Url,
+	}
+
+	if !importMode {
+		return starship, nil
+	}
+
+	created, err := parseTimestamp("created", r.Created)
+	if err != nil {
+		return nil, err
+	}
+
+	edited, err := parseTimestamp("edited", r.Edited)
+	if err != nil {
+		return nil, err
+	}
+
+	starship.Created = created
+	starship.Edited = edited
+
+	return starship, nil
+}
+
+type CreateStarshipResponse struct {
+	Id int `json:"id"`
+}
+
+type FindStarshipResponse struct {
+	Id                   int    `json:"Id"`
+	Name                 string `json:"name"`
+	Model                string `json:"model"`
+	Manufacturer         string `json:"manufacturer"`
+	CostInCredits        string `json:"cost_in_credits"`
+	Length               string `json:"length"`
+	MaxAtmospheringSpeed string `json:"max_atmosphering_speed"`
+	Crew                 string `json:"crew"`
+	Passengers           string `json:"passengers"`
+	CargoCapacity        string `json:"cargo_capacity"`
+	Consumables          string `json:"consumables"`
+	HyperdriveRating     string `json:"hyperdrive_rating"`
+	MGLT                 string `json:"MGLT"`
+	StarshipClass        string `json:"starship_class"`
+	Created              string `json:"created"`
+	Edited               string `json:"edited"`
+	Url                  string `json:"url"`
+}
+
+type ListStarshipsResponse struct {
+	Count   int                     `json:"count"`
+	Results []*FindStarshipResponse `json:"results"`
+}
+
+func CreateStarshipResponseFromDomain(s *model.StarshipIdentifier) *CreateStarshipResponse {
+	return &CreateStarshipResponse{
+		Id: s.Id,
+	}
+}
+
+func FindStarshipResponseFromDomain(s *model.StarshipDetail) *FindStarshipResponse {
+	return &FindStarshipResponse{
+		Id:                   s.Id.Id,
+		Name:                 s.Starship.Name,
+		Model:                s.Starship.Model,
+		Manufacturer:         s.Starship.Manufacturer,
+		CostInCredits:        s.Starship.CostInCredits,
+		Length:               s.Starship.Length,
+		MaxAtmospheringSpeed: s.Starship.MaxAtmospheringSpeed,
+		Crew:                 s.Starship.Crew,
+		Passengers:           s.Starship.Passengers,
+		CargoCapacity:        s.Starship.CargoCapacity,
+		Consumables:          s.Starship.Consumables,
+		HyperdriveRating:     s.Starship.HyperdriveRating,
+		MGLT:                 s.Starship.MGLT,
+		StarshipClass:        s.Starship.StarshipClass,
+		Created:              formatTimestamp(s.Starship.Created),
+		Edited:               formatTimestamp(s.Starship.Edited),
+		Url:                  s.Starship.Url,
+	}
+}
+
+func ListStarshipsResponseFromDomain(starshipDetails []*model.StarshipDetail) *ListStarshipsResponse {
+	results := make([]*FindStarshipResponse, 0, len(starshipDetails))
+	for _, starshipDetail := range starshipDetails {
+		results = append(results, FindStarshipResponseFromDomain(starshipDetail))
+	}
+
+	return &ListStarshipsResponse{
+		Count:   len(results),
+		Results: results,
+	}
+}
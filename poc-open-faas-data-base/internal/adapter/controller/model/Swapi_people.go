@@ -0,0 +1,124 @@
+package model
+
+import (
+	"fmt"
+	"handler/function/internal/application/model"
+	"time"
+)
+
+// swapiTimestampLayout is the microsecond layout swapi.dev uses for created and edited.
+const swapiTimestampLayout = "2006-01-02T15:04:05.000000Z"
+
+// SwapiPeopleResponse keeps the swapi.dev people schema, field names and order included.
+type SwapiPeopleResponse struct {
+	Name      string   `json:"name"`
+	Height    string   `json:"height"`
+	Mass      string   `json:"mass"`
+	HairColor string   `json:"hair_color"`
+	SkinColor string   `json:"skin_color"`
+	EyeColor  string   `json:"eye_color"`
+	BirthYear string   `json:"birth_year"`
+	Gender    string   `json:"gender"`
+	Homeworld string   `json:"homeworld"`
+	Films     []string `json:"films"`
+	Species   []string `json:"species"`
+	Vehicles  []string `json:"vehicles"`
+	Starships []string `json:"starships"`
+	Created   string   `json:"created"`
+	Edited    string   `json:"edited"`
+	Url       string   `json:"url"`
+}
+
+type SwapiPeopleListResponse struct {
+	Count    int                    `json:"count"`
+	Next     *string                `json:"next"`
+	Previous *string                `json:"previous"`
+	Results  []*SwapiPeopleResponse `json:"results"`
+}
+
+type SwapiErrorResponse struct {
+	Detail string `json:"detail"`
+}
+
+// SwapiLinks builds absolute swapi.dev style urls on the host that served the request,
+// BaseUrl is the equivalent of https://swapi.dev/api.
+type SwapiLinks struct {
+	BaseUrl string
+}
+
+func (l SwapiLinks) Resource(resource string, id int) string {
+	return fmt.Sprintf("%s/%s/%d/", l.BaseUrl, resource, id)
+}
+
+// Linked points planets, films, species, vehicles and starships to the routes that serve them,
+// only people have a swapi.dev representation.
+func (l SwapiLinks) Linked(resource string, id int) string {
+	return fmt.Sprintf("%s/v1/starwar/%s/%d", l.BaseUrl, resource, id)
+}
+
+func (l SwapiLinks) Page(resource string, page int) *string {
+	pageUrl := fmt.Sprintf("%s/%s/?page=%d", l.BaseUrl, resource, page)
+	return &pageUrl
+}
+
+func (l SwapiLinks) resources(resource string, resourceLinks []model.ResourceLink) []string {
+	urls := make([]string, 0, len(resourceLinks))
+	for _, resourceLink := range resourceLinks {
+		urls = append(urls, l.Linked(resource, resourceLink.Id))
+	}
+
+	return urls
+}
+
+func formatSwapiTimestamp(timestamp time.Time) string {
+	return timestamp.UTC().Format(swapiTimestampLayout)
+}
+
+func SwapiPeopleFromDomain(c *model.CharacterDetail, links SwapiLinks) *SwapiPeopleResponse {
+	homeworld := c.Character.Homeworld
+	if c.Character.HomeworldId != nil {
+		homeworld = links.Linked("planets", *c.Character.HomeworldId)
+	}
+
+	return &SwapiPeopleResponse{
+		Name:      c.Character.Name,
+		Height:    c.Character.Height.String(),
+		Mass:      c.Character.Mass.String(),
+		HairColor: c.Character.HairColor,
+		SkinColor: c.Character.SkinColor,
+		EyeColor:  c.Character.EyeColor,
+		BirthYear: c.Character.BirthYear.String(),
+		Gender:    c.Character.Gender,
+		Homeworld: homeworld,
+		Films:     links.resources(string(model.LinkFilms), c.Character.Films),
+		Species:   links.resources(string(model.LinkSpecies), c.Character.Species),
+		Vehicles:  links.resources(string(model.LinkVehicles), c.Character.Vehicles),
+		Starships: links.resources(string(model.LinkStarships), c.Character.Starships),
+		Created:   formatSwapiTimestamp(c.Character.Created),
+		Edited:    formatSwapiTimestamp(c.Character.Edited),
+		Url:       links.Resource("people", c.Id.Id),
+	}
+}
+
+// SwapiPeopleListFromDomain pages like swapi.dev, next and previous are null at the edges.
+func SwapiPeopleListFromDomain(p *model.CharacterPage, page int, links SwapiLinks) *SwapiPeopleListResponse {
+	results := make([]*SwapiPeopleResponse, 0, len(p.Characters))
+	for _, characterDetail := range p.Characters {
+		results = append(results, SwapiPeopleFromDomain(characterDetail, links))
+	}
+
+	response := &SwapiPeopleListResponse{
+		Count:   p.Total,
+		Results: results,
+	}
+
+	if p.Offset+len(p.Characters) < p.Total {
+		response.Next = links.Page("people", page+1)
+	}
+
+	if page > 1 {
+		response.Previous = links.Page("people", page-1)
+	}
+
+	return response
+}
@@ -0,0 +1,122 @@
+package model
+
+import "handler/function/internal/application/model"
+
+type CreateVehicleRequest struct {
+	Name                 string `json:"name"`
+	Model                string `json:"model"`
+	Manufacturer         string `json:"manufacturer"`
+	CostInCredits        string `json:"cost_in_credits"`
+	Length               string `json:"length"`
+	MaxAtmospheringSpeed string `json:"max_atmosphering_speed"`
+	Crew                 string `json:"crew"`
+	Passengers           string `json:"passengers"`
+	CargoCapacity        string `json:"cargo_capacity"`
+	Consumables          string `json:"consumables"`
+	VehicleClass         string `json:"vehicle_class"`
+	Created              string `json:"created"`
+	Edited               string `json:"edited"`
+	Url                  string `json:"url"`
+}
+
+// ToDomain follows the character rules, created and edited are only kept in import mode.
+func (r CreateVehicleRequest) ToDomain(importMode bool) (*model.Vehicle, error) {
+	vehicle := &model.Vehicle{
+		Name:                 r.Name,
+		Model:                r.Model,
+		Manufacturer:         r.Manufacturer,
+		CostInCredits:        r.CostInCredits,
+		Length:               r.Length,
+		MaxAtmospheringSpeed: r.MaxAtmospheringSpeed,
+		Crew:                 r.Crew,
+		Passengers:           r.Passengers,
+		CargoCapacity:        r.CargoCapacity,
+		Consumables:          r.Consumables,
+		VehicleClass:         r.VehicleClass,
+		Url:                  r.Url,
+	}
+
+	if !importMode {
+		return vehicle, nil
+	}
+
+	created, err := parseTimestamp("created", r.Created)
+	if err != nil {
+		return nil, err
+	}
+
+	edited, err := parseTimestamp("edited", r.Edited)
+	if err != nil {
+		return nil, err
+	}
+
+	vehicle.Created = created
+	vehicle.Edited = edited
+
+	return vehicle, nil
+}
+
+type CreateVehicleResponse struct {
+	Id int `json:"id"`
+}
+
+type FindVehicleResponse struct {
+	Id                   int    `json:"Id"`
+	Name                 string `json:"name"`
+	Model                string `json:"model"`
+	Manufacturer         string `json:"manufacturer"`
+	CostInCredits        string `json:"cost_in_credits"`
+	Length               string `json:"length"`
+	MaxAtmospheringSpeed string `json:"max_atmosphering_speed"`
+	Crew                 string `json:"crew"`
+	Passengers           string `json:"passengers"`
+	CargoCapacity        string `json:"cargo_capacity"`
+	Consumables          string `json:"consumables"`
+	VehicleClass         string `json:"vehicle_class"`
+	Created              string `json:"created"`
+	Edited               string `json:"edited"`
+	Url                  string `json:"url"`
+}
+
+type ListVehiclesResponse struct {
+	Count   int                    `json:"count"`
+	Results []*FindVehicleResponse `json:"results"`
+}
+
+func CreateVehicleResponseFromDomain(v *model.VehicleIdentifier) *CreateVehicleResponse {
+	return &CreateVehicleResponse{
+		Id: v.Id,
+	}
+}
+
+func FindVehicleResponseFromDomain(v *model.VehicleDetail) *FindVehicleResponse {
+	return &FindVehicleResponse{
+		Id:                   v.Id.Id,
+		Name:                 v.Vehicle.Name,
+		Model:                v.Vehicle.Model,
+		Manufacturer:         v.Vehicle.Manufacturer,
+		CostInCredits:        v.Vehicle.CostInCredits,
+		Length:               v.Vehicle.Length,
+		MaxAtmospheringSpeed: v.Vehicle.MaxAtmospheringSpeed,
+		Crew:                 v.Vehicle.Crew,
+		Passengers:           v.Vehicle.Passengers,
+		CargoCapacity:        v.Vehicle.CargoCapacity,
+		Consumables:          v.Vehicle.Consumables,
+		VehicleClass:         v.Vehicle.VehicleClass,
+		Created:              formatTimestamp(v.Vehicle.Created),
+		Edited:               formatTimestamp(v.Vehicle.Edited),
+		Url:                  v.Vehicle.Url,
+	}
+}
+
+func ListVehiclesResponseFromDomain(vehicleDetails []*model.VehicleDetail) *ListVehiclesResponse {
+	results := make([]*FindVehicleResponse, 0, len(vehicleDetails))
+	for _, vehicleDetail := range vehicleDetails {
+		results = append(results, FindVehicleResponseFromDomain(vehicleDetail))
+	}
+
+	return &ListVehiclesResponse{
+		Count:   len(results),
+		Results: results,
+	}
+}
@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/adapter/openapi"
+	openapiModel "handler/function/internal/adapter/openapi/model"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestCharacterContract validates what the character routes answer against the OpenAPI document.
+func TestCharacterContract(t *testing.T) {
+
+	homeworldId := 1
+	characterWithHomeworld := character
+	characterWithHomeworld.HomeworldId = &homeworldId
+	characterWithHomeworld.Films = []model.ResourceLink{{Id: 1, Url: "https://swapi.dev/api/films/1/"}}
+
+	characterDetail := &model.CharacterDetail{Id: &CreateCharacterIdentifier, Character: &characterWithHomeworld, Version: 1}
+
+	createMock := CreateCharacterControllerMock{}
+	createMock.On("CreateCharacter", mock.Anything, mock.Anything).Return(&CreateCharacterIdentifier, nil)
+
+	findMock := FindCharacterControllerMock{}
+	findMock.On("FindCharacter", &model.CharacterIdentifier{Id: 1}, mock.Anything).Return(characterDetail, nil)
+	findMock.On("FindCharacter", &model.CharacterIdentifier{Id: 99}, mock.Anything).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Character not found"})
+	findMock.On("FindCharacterProjection", &model.CharacterIdentifier{Id: 1}, mock.Anything, mock.Anything).Return(characterDetail, nil)
+
+	updateMock := UpdateCharacterControllerMock{}
+	updateMock.On("UpdateCharacter", mock.Anything, mock.Anything).Return(characterDetail, nil)
+
+	listMock := ListCharactersControllerMock{}
+	listMock.On("ListCharacters", mock.Anything, mock.Anything).
+		Return(&model.CharacterPage{Characters: []*model.CharacterDetail{characterDetail}, Total: 1, Limit: 10}, nil)
+
+	findPlanetMock := FindPlanetControllerMock{}
+	findPlanetMock.On("FindPlanet", &model.PlanetIdentifier{Id: 1}, mock.Anything).
+		Return(&model.PlanetDetail{Id: &PlanetIdentifier, Planet: &planet, Version: 1}, nil)
+
+	controller := NewStarWarController(&createMock, &findMock, &updateMock, &listMock, &findPlanetMock)
+
+	searchMock := SearchCharactersControllerMock{}
+	searchMock.On("SearchCharacters", mock.Anything, mock.Anything).
+		Return(&model.CharacterSearchResult{
+			Matches: []*model.CharacterMatch{{Character: characterDetail, Score: 0.53, Highlights: map[string]string{"name": "Darth <em>Ezequiel</em>"}}},
+			Total:   1,
+			Limit:   10,
+		}, nil)
+
+	statsMock := FindCharacterStatsControllerMock{}
+	statsMock.On("FindCharacterStats", mock.Anything, mock.Anything).
+		Return(&model.CharacterStats{
+			Total:       1,
+			ByGender:    []model.GroupCount{{Value: "male", Count: 1}},
+			ByEyeColor:  []model.GroupCount{{Value: "yellow", Count: 1}},
+			ByHomeworld: []model.GroupCount{},
+			Height:      model.MeasureStats{Min: &characterHeight, Max: &characterHeight, Avg: &characterHeight, Unit: model.HeightUnit, Samples: 1},
+			Mass:        model.MeasureStats{Unit: model.MassUnit},
+		}, nil)
+
+	searchController := NewCharacterSearchController(&searchMock)
+	statsController := NewCharacterStatsController(&statsMock)
+
+	validator, err := openapi.NewValidator(&openapiModel.ValidatorOptions{Requests: true, Responses: true}, context.Background())
+	assert.Nil(t, err)
+
+	type testCase struct {
+		name       string
+		method     string
+		url        string
+		body       string
+		ifMatch    string
+		handler    http.HandlerFunc
+		statusCode int
+	}
+
+	characterBody := `{"name": "Darth Vader", "height": "202", "mass": "136", "birth_year": "41.9BBY", "films": ["https://swapi.dev/api/films/1/", 2]}`
+
+	testCases := []testCase{
+		{name: "create", method: http.MethodPost, url: "/api/v1/starwar/characters", body: characterBody, handler: controller.CreateStarWarCharacter, statusCode: http.StatusOK},
+		{name: "create invalid mass", method: http.MethodPost, url: "/api/v1/starwar/characters", body: `{"mass": "heavy"}`, handler: controller.CreateStarWarCharacter, statusCode: http.StatusBadRequest},
+		{name: "find", method: http.MethodGet, url: "/api/v1/starwar/characters/1", handler: controller.FindStarWarCharacter, statusCode: http.StatusOK},
+		{name: "find expanded", method: http.MethodGet, url: "/api/v1/starwar/characters/1?expand=homeworld", handler: controller.FindStarWarCharacter, statusCode: http.StatusOK},
+		{name: "find projected", method: http.MethodGet, url: "/api/v1/starwar/characters/1?fields=name,height", handler: controller.FindStarWarCharacter, statusCode: http.StatusOK},
+		{name: "find not found", method: http.MethodGet, url: "/api/v1/starwar/characters/99", handler: controller.FindStarWarCharacter, statusCode: http.StatusNotFound},
+		{name: "update", method: http.MethodPut, url: "/api/v1/starwar/characters/1", body: characterBody, ifMatch: `"1"`, handler: controller.UpdateStarWarCharacter, statusCode: http.StatusOK},
+		{name: "update without If-Match", method: http.MethodPut, url: "/api/v1/starwar/characters/1", body: characterBody, handler: controller.UpdateStarWarCharacter, statusCode: http.StatusPreconditionRequired},
+		{name: "list", method: http.MethodGet, url: "/api/v1/starwar/characters?sort=-height&limit=10", handler: controller.ListStarWarCharacters, statusCode: http.StatusOK},
+		{name: "list projected", method: http.MethodGet, url: "/api/v1/starwar/characters?fields=name", handler: controller.ListStarWarCharacters, statusCode: http.StatusOK},
+		{name: "search", method: http.MethodGet, url: "/api/v1/starwar/characters/search?q=ezekiel", handler: searchController.SearchStarWarCharacters, statusCode: http.StatusOK},
+		{name: "search projected", method: http.MethodGet, url: "/api/v1/starwar/characters/search?q=ezekiel&fields=name", handler: searchController.SearchStarWarCharacters, statusCode: http.StatusOK},
+		{name: "stats", method: http.MethodGet, url: "/api/v1/starwar/characters/stats?min_height=100", handler: statsController.FindCharacterStats, statusCode: http.StatusOK},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			var body io.Reader
+			if test.body != "" {
+				body = strings.NewReader(test.body)
+			}
+
+			newRequest := httptest.NewRequest(test.method, test.url, body)
+			if test.body != "" {
+				newRequest.Header.Set("Content-Type", "application/json")
+			}
+			if test.ifMatch != "" {
+				newRequest.Header.Set("If-Match", test.ifMatch)
+			}
+
+			response := httptest.NewRecorder()
+
+			validator.Validated(test.handler)(response, newRequest)
+
+			responseBody, _ := io.ReadAll(response.Result().Body)
+			assert.EqualValues(t, test.statusCode, response.Result().StatusCode, string(responseBody))
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package controller
+
+import (
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/pkg"
+	"net/http"
+)
+
+type PlanetController struct {
+	createPlanet in.CreatePlanet
+	findPlanet   in.FindPlanet
+	updatePlanet in.UpdatePlanet
+	deletePlanet in.DeletePlanet
+}
+
+func NewPlanetController(
+	createPlanet in.CreatePlanet,
+	findPlanet in.FindPlanet,
+	updatePlanet in.UpdatePlanet,
+	deletePlanet in.DeletePlanet,
+) *PlanetController {
+	return &PlanetController{
+		createPlanet: createPlanet,
+		findPlanet:   findPlanet,
+		updatePlanet: updatePlanet,
+		deletePlanet: deletePlanet,
+	}
+}
+
+func GetPlanetRequestBody(r *http.Request) (*controllerModel.CreatePlanetRequest, *pkg.GenericException) {
+
+	requestBody := &controllerModel.CreatePlanetRequest{}
+
+	if err := decodeRequest(r, requestBody); err != nil {
+		return nil, err
+	}
+
+	return requestBody, nil
+}
+
+func getPlanetIdentifier(r *http.Request) (*model.PlanetIdentifier, error) {
+	pathParam, pathError := getPathId(r)
+
+	if pathError != nil {
+		return nil, pathError
+	}
+
+	return &model.PlanetIdentifier{Id: pathParam}, nil
+}
+
+func (c *PlanetController) CreatePlanet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	requestBody, requestError := GetPlanetRequestBody(r)
+
+	if requestError != nil {
+		w.WriteHeader(requestError.StatusCode)
+		w.Write([]byte(requestError.Msj))
+		return
+	}
+
+	planet, domainError := requestBody.ToDomain(isImportMode(r))
+
+	if domainError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(domainError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	createResult, err := c.createPlanet.CreatePlanet(planet, ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, controllerModel.CreatePlanetResponseFromDomain(createResult))
+}
+
+func (c *PlanetController) FindPlanet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	planetIdentifier, pathError := getPlanetIdentifier(r)
+
+	if pathError != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Invalid planet identifier"))
+		return
+	}
+
+	findResult, err := c.findPlanet.FindPlanet(planetIdentifier, ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	w.Header().Set("ETag", formatETag(findResult.Version))
+
+	if matchIfNoneMatch(r, findResult.Version) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, controllerModel.FindPlanetResponseFromDomain(findResult))
+}
+
+func (c *PlanetController) UpdatePlanet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	planetIdentifier, pathError := getPlanetIdentifier(r)
+
+	if pathError != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Invalid planet identifier"))
+		return
+	}
+
+	if r.Header.Get("If-Match") == "" {
+		w.WriteHeader(http.StatusPreconditionRequired)
+		w.Write([]byte("If-Match header is required"))
+		return
+	}
+
+	version, validETag := getIfMatchVersion(r)
+
+	if !validETag {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		w.Write([]byte("If-Match header must be the planet ETag"))
+		return
+	}
+
+	requestBody, requestError := GetPlanetRequestBody(r)
+
+	if requestError != nil {
+		w.WriteHeader(requestError.StatusCode)
+		w.Write([]byte(requestError.Msj))
+		return
+	}
+
+	planet, domainError := requestBody.ToDomain(false)
+
+	if domainError != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(domainError)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	planetDetail := &model.PlanetDetail{
+		Id:      planetIdentifier,
+		Planet:  planet,
+		Version: version,
+	}
+
+	updateResult, err := c.updatePlanet.UpdatePlanet(planetDetail, ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	w.Header().Set("ETag", formatETag(updateResult.Version))
+	writeResponse(w, r, http.StatusOK, controllerModel.FindPlanetResponseFromDomain(updateResult))
+}
+
+func (c *PlanetController) DeletePlanet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	planetIdentifier, pathError := getPlanetIdentifier(r)
+
+	if pathError != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Invalid planet identifier"))
+		return
+	}
+
+	err := c.deletePlanet.DeletePlanet(planetIdentifier, ctx)
+
+	if err != nil {
+		statusCode, errorMsj := pkg.GetErrorDetail(err)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(errorMsj))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
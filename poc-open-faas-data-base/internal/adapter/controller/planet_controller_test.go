@@ -0,0 +1,246 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var planet = model.Planet{
+	Name:           "Tatooine",
+	RotationPeriod: "23",
+	OrbitalPeriod:  "304",
+	Diameter:       "10465",
+	Climate:        "arid",
+	Gravity:        "1 standard",
+	Terrain:        "desert",
+	SurfaceWater:   "1",
+	Population:     "200000",
+	Created:        time.Date(2014, 12, 9, 13, 50, 49, 641000000, time.UTC),
+	Edited:         time.Date(2014, 12, 20, 20, 58, 18, 411000000, time.UTC),
+	Url:            "https://swapi.dev/api/planets/1/",
+}
+
+var PlanetIdentifier = model.PlanetIdentifier{
+	Id: 1,
+}
+
+type CreatePlanetControllerMock struct {
+	mock.Mock
+}
+type FindPlanetControllerMock struct {
+	mock.Mock
+}
+type UpdatePlanetControllerMock struct {
+	mock.Mock
+}
+type DeletePlanetControllerMock struct {
+	mock.Mock
+}
+
+func (c *CreatePlanetControllerMock) CreatePlanet(
+	planet *model.Planet,
+	ctx context.Context) (*model.PlanetIdentifier, error) {
+
+	args := c.Called(planet, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.PlanetIdentifier), nil
+}
+
+func (f *FindPlanetControllerMock) FindPlanet(
+	planet *model.PlanetIdentifier,
+	ctx context.Context) (*model.PlanetDetail, error) {
+
+	args := f.Called(planet, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.PlanetDetail), nil
+}
+
+func (u *UpdatePlanetControllerMock) UpdatePlanet(
+	planet *model.PlanetDetail,
+	ctx context.Context) (*model.PlanetDetail, error) {
+
+	args := u.Called(planet, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.PlanetDetail), nil
+}
+
+func (d *DeletePlanetControllerMock) DeletePlanet(
+	planet *model.PlanetIdentifier,
+	ctx context.Context) error {
+
+	args := d.Called(planet, ctx)
+
+	return args.Error(0)
+}
+
+func newPlanetController() (*PlanetController, *CreatePlanetControllerMock, *FindPlanetControllerMock, *UpdatePlanetControllerMock, *DeletePlanetControllerMock) {
+	createMock := &CreatePlanetControllerMock{}
+	findMock := &FindPlanetControllerMock{}
+	updateMock := &UpdatePlanetControllerMock{}
+	deleteMock := &DeletePlanetControllerMock{}
+
+	return NewPlanetController(createMock, findMock, updateMock, deleteMock), createMock, findMock, updateMock, deleteMock
+}
+
+func newPlanetRequestBody() *bytes.Buffer {
+	request := controllerModel.CreatePlanetRequest{
+		Name:    "Tatooine",
+		Climate: "arid",
+		Terrain: "desert",
+		Url:     "https://swapi.dev/api/planets/1/",
+	}
+	body, _ := json.Marshal(request)
+	return bytes.NewBuffer(body)
+}
+
+func TestPlanetController_CreatePlanet(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodPost, "/api/v1/starwar/planets", newPlanetRequestBody())
+	response := httptest.NewRecorder()
+
+	controller, createMock, _, _, _ := newPlanetController()
+	createMock.On("CreatePlanet", mock.IsType(&model.Planet{}), newRequest.Context()).Return(&PlanetIdentifier, nil)
+
+	controller.CreatePlanet(response, newRequest)
+
+	result := controllerModel.CreatePlanetResponse{}
+	json.NewDecoder(response.Body).Decode(&result)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, 1, result.Id)
+}
+
+func TestPlanetController_CreatePlanetInvalidBody(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodPost, "/api/v1/starwar/planets", bytes.NewBufferString("{"))
+	response := httptest.NewRecorder()
+
+	controller, _, _, _, _ := newPlanetController()
+	controller.CreatePlanet(response, newRequest)
+
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+}
+
+func TestPlanetController_FindPlanet(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/planets/1", nil)
+	response := httptest.NewRecorder()
+
+	controller, _, findMock, _, _ := newPlanetController()
+	findMock.On("FindPlanet", &PlanetIdentifier, newRequest.Context()).
+		Return(&model.PlanetDetail{Id: &PlanetIdentifier, Planet: &planet, Version: 2}, nil)
+
+	controller.FindPlanet(response, newRequest)
+
+	result := controllerModel.FindPlanetResponse{}
+	json.NewDecoder(response.Body).Decode(&result)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, "\"2\"", response.Result().Header.Get("ETag"))
+	assert.EqualValues(t, "Tatooine", result.Name)
+}
+
+func TestPlanetController_FindPlanetNotModified(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/planets/1", nil)
+	newRequest.Header.Set("If-None-Match", "\"2\"")
+	response := httptest.NewRecorder()
+
+	controller, _, findMock, _, _ := newPlanetController()
+	findMock.On("FindPlanet", &PlanetIdentifier, newRequest.Context()).
+		Return(&model.PlanetDetail{Id: &PlanetIdentifier, Planet: &planet, Version: 2}, nil)
+
+	controller.FindPlanet(response, newRequest)
+
+	assert.EqualValues(t, http.StatusNotModified, response.Result().StatusCode)
+}
+
+func TestPlanetController_FindPlanetNotFound(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/planets/9", nil)
+	response := httptest.NewRecorder()
+
+	controller, _, findMock, _, _ := newPlanetController()
+	findMock.On("FindPlanet", mock.IsType(&model.PlanetIdentifier{}), newRequest.Context()).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Planet not found"})
+
+	controller.FindPlanet(response, newRequest)
+
+	assert.EqualValues(t, http.StatusNotFound, response.Result().StatusCode)
+}
+
+func TestPlanetController_UpdatePlanet(t *testing.T) {
+	type testCase struct {
+		name       string
+		ifMatch    string
+		statusCode int
+	}
+
+	testCases := []testCase{
+		{name: "updated", ifMatch: "\"2\"", statusCode: http.StatusOK},
+		{name: "missing if-match", ifMatch: "", statusCode: http.StatusPreconditionRequired},
+		{name: "invalid if-match", ifMatch: "abc", statusCode: http.StatusPreconditionFailed},
+		{name: "weak if-match", ifMatch: "W/\"2\"", statusCode: http.StatusPreconditionFailed},
+		{name: "any version", ifMatch: "*", statusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newRequest := httptest.NewRequest(http.MethodPut, "/api/v1/starwar/planets/1", newPlanetRequestBody())
+			if tc.ifMatch != "" {
+				newRequest.Header.Set("If-Match", tc.ifMatch)
+			}
+			response := httptest.NewRecorder()
+
+			controller, _, _, updateMock, _ := newPlanetController()
+			updateMock.On("UpdatePlanet", mock.IsType(&model.PlanetDetail{}), newRequest.Context()).
+				Return(&model.PlanetDetail{Id: &PlanetIdentifier, Planet: &planet, Version: 3}, nil)
+
+			controller.UpdatePlanet(response, newRequest)
+
+			assert.EqualValues(t, tc.statusCode, response.Result().StatusCode)
+		})
+	}
+}
+
+func TestPlanetController_DeletePlanet(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodDelete, "/api/v1/starwar/planets/1", nil)
+	response := httptest.NewRecorder()
+
+	controller, _, _, _, deleteMock := newPlanetController()
+	deleteMock.On("DeletePlanet", &PlanetIdentifier, newRequest.Context()).Return(nil)
+
+	controller.DeletePlanet(response, newRequest)
+
+	assert.EqualValues(t, http.StatusNoContent, response.Result().StatusCode)
+}
+
+func TestPlanetController_DeletePlanetNotFound(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodDelete, "/api/v1/starwar/planets/9", nil)
+	response := httptest.NewRecorder()
+
+	controller, _, _, _, deleteMock := newPlanetController()
+	deleteMock.On("DeletePlanet", mock.IsType(&model.PlanetIdentifier{}), newRequest.Context()).
+		Return(pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Planet not found"})
+
+	controller.DeletePlanet(response, newRequest)
+
+	assert.EqualValues(t, http.StatusNotFound, response.Result().StatusCode)
+}
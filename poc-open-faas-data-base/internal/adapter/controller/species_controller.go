@@ -0,0 +1,27 @@
+package controller
+
+import (
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+)
+
+// SpeciesController serves /species and /characters/{id}/species.
+type SpeciesController = LinkedResourceController[*model.Species, *model.SpeciesIdentifier, *model.SpeciesDetail]
+
+func NewSpeciesController(
+	createSpecies in.CreateLinkedResource[*model.Species, *model.SpeciesIdentifier],
+	findSpecies in.FindLinkedResource[*model.SpeciesIdentifier, *model.SpeciesDetail],
+	listCharacterSpecies in.ListCharacterLinkedResources[*model.SpeciesDetail],
+	listLinkedCharacters in.ListLinkedCharacters,
+) *SpeciesController {
+	return newLinkedResourceController(linkedResourceMapping[*model.Species, *model.SpeciesIdentifier, *model.SpeciesDetail]{
+		name:           "species",
+		kind:           model.LinkSpecies,
+		toDomain:       decodeLinkedResource(controllerModel.CreateSpeciesRequest.ToDomain),
+		identifier:     func(id int) *model.SpeciesIdentifier { return &model.SpeciesIdentifier{Id: id} },
+		createResponse: asResponse(controllerModel.CreateSpeciesResponseFromDomain),
+		findResponse:   asResponse(controllerModel.FindSpeciesResponseFromDomain),
+		listResponse:   asResponse(controllerModel.ListSpeciesResponseFromDomain),
+	}, createSpecies, findSpecies, listCharacterSpecies, listLinkedCharacters)
+}
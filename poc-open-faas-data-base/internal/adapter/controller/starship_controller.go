@@ -0,0 +1,27 @@
+package controller
+
+import (
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+)
+
+// StarshipController serves /starships and /characters/{id}/starships.
+type StarshipController = LinkedResourceController[*model.Starship, *model.StarshipIdentifier, *model.StarshipDetail]
+
+func NewStarshipController(
+	createStarship in.CreateLinkedResource[*model.Starship, *model.StarshipIdentifier],
+	findStarship in.FindLinkedResource[*model.StarshipIdentifier, *model.StarshipDetail],
+	listCharacterStarships in.ListCharacterLinkedResources[*model.StarshipDetail],
+	listLinkedCharacters in.ListLinkedCharacters,
+) *StarshipController {
+	return newLinkedResourceController(linkedResourceMapping[*model.Starship, *model.StarshipIdentifier, *model.StarshipDetail]{
+		name:           "starship",
+		kind:           model.LinkStarships,
+		toDomain:       decodeLinkedResource(controllerModel.CreateStarshipRequest.ToDomain),
+		identifier:     func(id int) *model.StarshipIdentifier { return &model.StarshipIdentifier{Id: id} },
+		createResponse: asResponse(controllerModel.CreateStarshipResponseFromDomain),
+		findResponse:   asResponse(controllerModel.FindStarshipResponseFromDomain),
+		listResponse:   asResponse(controllerModel.ListStarshipsResponseFromDomain),
+	}, createStarship, findStarship, listCharacterStarships, listLinkedCharacters)
+}
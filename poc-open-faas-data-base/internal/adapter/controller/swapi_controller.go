@@ -0,0 +1,165 @@
+package controller
+
+import (
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/pkg"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// swapiPageSize is the fixed page size of swapi.dev listings.
+const swapiPageSize = 10
+
+// SwapiController serves /api/people/ with the swapi.dev representation,
+// so clients switch from swapi.dev by changing only the base url.
+type SwapiController struct {
+	findCharacter  in.FindCharacter
+	listCharacters in.ListCharacters
+	linkOptions    SwapiLinkOptions
+}
+
+// SwapiLinkOptions is where the links point. BaseUrl is the public url of the function, when it is empty
+// the request host is used and the X-Forwarded headers only when TrustForwardedHeaders is set, as any
+// client can send them to a function that is not behind a gateway.
+type SwapiLinkOptions struct {
+	BaseUrl               string
+	TrustForwardedHeaders bool
+}
+
+func NewSwapiController(findCharacter in.FindCharacter, listCharacters in.ListCharacters, linkOptions SwapiLinkOptions) *SwapiController {
+	return &SwapiController{
+		findCharacter:  findCharacter,
+		listCharacters: listCharacters,
+		linkOptions:    linkOptions,
+	}
+}
+
+// getSwapiLinks uses the configured base url, or the scheme and host the client used. Behind a trusted gateway
+// the forwarded headers give them along with the path prefix of the function, e.g. /function/poc-open-faas-data-base.
+func (c *SwapiController) getSwapiLinks(r *http.Request) controllerModel.SwapiLinks {
+	if c.linkOptions.BaseUrl != "" {
+		return controllerModel.SwapiLinks{BaseUrl: strings.TrimSuffix(c.linkOptions.BaseUrl, "/") + "/api"}
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	host := r.Host
+	prefix := ""
+
+	if c.linkOptions.TrustForwardedHeaders {
+		if forwardedProto := firstForwardedValue(r, "X-Forwarded-Proto"); forwardedProto != "" {
+			scheme = forwardedProto
+		}
+
+		if forwardedHost := firstForwardedValue(r, "X-Forwarded-Host"); forwardedHost != "" {
+			host = forwardedHost
+		}
+
+		prefix = getForwardedPrefix(r)
+	}
+
+	return controllerModel.SwapiLinks{BaseUrl: scheme + "://" + host + prefix + "/api"}
+}
+
+// getForwardedPrefix reads X-Forwarded-Prefix, or the part of X-Forwarded-Uri before the path the function received,
+// as the OpenFaaS gateway forwards /function/<name>/api/people/ to the function as /api/people/.
+func getForwardedPrefix(r *http.Request) string {
+	prefix := firstForwardedValue(r, "X-Forwarded-Prefix")
+
+	if prefix == "" {
+		forwardedUri := firstForwardedValue(r, "X-Forwarded-Uri")
+		if index := strings.IndexAny(forwardedUri, "?#"); index >= 0 {
+			forwardedUri = forwardedUri[:index]
+		}
+
+		if strings.HasSuffix(forwardedUri, r.URL.Path) {
+			prefix = strings.TrimSuffix(forwardedUri, r.URL.Path)
+		}
+	}
+
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix != "" && !strings.HasPrefix(prefix, "/") {
+		prefix = "/" + prefix
+	}
+
+	return prefix
+}
+
+// firstForwardedValue keeps the value of the first proxy when a header lists several.
+func firstForwardedValue(r *http.Request, header string) string {
+	return strings.TrimSpace(strings.Split(r.Header.Get(header), ",")[0])
+}
+
+// writeSwapiError answers like swapi.dev, a detail for missing resources.
+func writeSwapiError(w http.ResponseWriter, r *http.Request, err error) {
+	statusCode, errorMsj := pkg.GetErrorDetail(err)
+
+	if statusCode == http.StatusNotFound {
+		writeResponse(w, r, http.StatusNotFound, controllerModel.SwapiErrorResponse{Detail: "Not found"})
+		return
+	}
+
+	w.WriteHeader(statusCode)
+	w.Write([]byte(errorMsj))
+}
+
+func (c *SwapiController) ListPeople(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	page := 1
+	if value := r.URL.Query().Get("page"); value != "" {
+		number, err := strconv.Atoi(value)
+		if err != nil || number < 1 {
+			writeResponse(w, r, http.StatusNotFound, controllerModel.SwapiErrorResponse{Detail: "Not found"})
+			return
+		}
+		page = number
+	}
+
+	characterQuery := &model.CharacterQuery{
+		Sort:   []model.SortOrder{{Field: "id"}},
+		Limit:  swapiPageSize,
+		Offset: (page - 1) * swapiPageSize,
+	}
+
+	listResult, err := c.listCharacters.ListCharacters(characterQuery, ctx)
+
+	if err != nil {
+		writeSwapiError(w, r, err)
+		return
+	}
+
+	if page > 1 && len(listResult.Characters) == 0 {
+		writeResponse(w, r, http.StatusNotFound, controllerModel.SwapiErrorResponse{Detail: "Not found"})
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, controllerModel.SwapiPeopleListFromDomain(listResult, page, c.getSwapiLinks(r)))
+}
+
+func (c *SwapiController) FindPerson(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	split := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	id, pathError := strconv.Atoi(split[len(split)-1])
+
+	if pathError != nil {
+		writeResponse(w, r, http.StatusNotFound, controllerModel.SwapiErrorResponse{Detail: "Not found"})
+		return
+	}
+
+	findResult, err := c.findCharacter.FindCharacter(&model.CharacterIdentifier{Id: id}, ctx)
+
+	if err != nil {
+		writeSwapiError(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, controllerModel.SwapiPeopleFromDomain(findResult, c.getSwapiLinks(r)))
+}
@@ -0,0 +1,234 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func swapiCharacterDetail() *model.CharacterDetail {
+	homeworldId := 1
+	swapiCharacter := character
+	swapiCharacter.HomeworldId = &homeworldId
+	swapiCharacter.Films = []model.ResourceLink{{Id: 1, Url: "https://swapi.dev/api/films/1/"}, {Id: 2}}
+	swapiCharacter.Starships = []model.ResourceLink{{Id: 13}}
+
+	return &model.CharacterDetail{Id: &model.CharacterIdentifier{Id: 4}, Character: &swapiCharacter, Version: 1}
+}
+
+func TestSwapiController_FindPerson(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "http://faas.example.com/api/people/4/", nil)
+	response := httptest.NewRecorder()
+
+	findCharacterControllerMock := FindCharacterControllerMock{}
+	findCharacterControllerMock.On("FindCharacter", &model.CharacterIdentifier{Id: 4}, newRequest.Context()).
+		Return(swapiCharacterDetail(), nil)
+
+	controller := NewSwapiController(&findCharacterControllerMock, &ListCharactersControllerMock{}, SwapiLinkOptions{})
+	controller.FindPerson(response, newRequest)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.JSONEq(t, `{
+		"name": "Darth Ezequiel",
+		"height": "202",
+		"mass": "136",
+		"hair_color": "none",
+		"skin_color": "white",
+		"eye_color": "yellow",
+		"birth_year": "41.9BBY",
+		"gender": "male",
+		"homeworld": "http://faas.example.com/api/v1/starwar/planets/1",
+		"films": ["http://faas.example.com/api/v1/starwar/films/1", "http://faas.example.com/api/v1/starwar/films/2"],
+		"species": [],
+		"vehicles": [],
+		"starships": ["http://faas.example.com/api/v1/starwar/starships/13"],
+		"created": "2014-12-10T15:18:20.704000Z",
+		"edited": "2014-12-20T21:17:50.313000Z",
+		"url": "http://faas.example.com/api/people/4/"
+	}`, response.Body.String())
+}
+
+func TestSwapiController_FindPersonLinks(t *testing.T) {
+
+	type testCase struct {
+		name        string
+		linkOptions SwapiLinkOptions
+		headers     map[string]string
+		url         string
+	}
+
+	forwarded := map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "starwars.example.com"}
+
+	testCases := []testCase{
+		{
+			name:    "untrusted forwarded headers",
+			headers: map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "evil.example.com", "X-Forwarded-Prefix": "/evil"},
+			url:     "http://gateway:8080/api/people/4/",
+		},
+		{
+			name:        "trusted forwarded host",
+			linkOptions: SwapiLinkOptions{TrustForwardedHeaders: true},
+			headers:     forwarded,
+			url:         "https://starwars.example.com/api/people/4/",
+		},
+		{
+			name:        "trusted forwarded prefix",
+			linkOptions: SwapiLinkOptions{TrustForwardedHeaders: true},
+			headers: map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "starwars.example.com",
+				"X-Forwarded-Prefix": "/function/poc-open-faas-data-base/"},
+			url: "https://starwars.example.com/function/poc-open-faas-data-base/api/people/4/",
+		},
+		{
+			name:        "trusted forwarded uri of the gateway",
+			linkOptions: SwapiLinkOptions{TrustForwardedHeaders: true},
+			headers: map[string]string{"X-Forwarded-Host": "gateway.example.com:8080",
+				"X-Forwarded-Uri": "/function/poc-open-faas-data-base/api/people/4?format=json"},
+			url: "http://gateway.example.com:8080/function/poc-open-faas-data-base/api/people/4/",
+		},
+		{
+			name:        "configured base url",
+			linkOptions: SwapiLinkOptions{BaseUrl: "https://faas.example.com/function/poc-open-faas-data-base/", TrustForwardedHeaders: true},
+			headers:     forwarded,
+			url:         "https://faas.example.com/function/poc-open-faas-data-base/api/people/4/",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			newRequest := httptest.NewRequest(http.MethodGet, "http://gateway:8080/api/people/4", nil)
+			for header, value := range test.headers {
+				newRequest.Header.Set(header, value)
+			}
+			response := httptest.NewRecorder()
+
+			findCharacterControllerMock := FindCharacterControllerMock{}
+			findCharacterControllerMock.On("FindCharacter", &model.CharacterIdentifier{Id: 4}, newRequest.Context()).
+				Return(swapiCharacterDetail(), nil)
+
+			controller := NewSwapiController(&findCharacterControllerMock, &ListCharactersControllerMock{}, test.linkOptions)
+			controller.FindPerson(response, newRequest)
+
+			result := controllerModel.SwapiPeopleResponse{}
+			json.NewDecoder(response.Body).Decode(&result)
+
+			assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+			assert.EqualValues(t, test.url, result.Url)
+		})
+	}
+}
+
+func TestSwapiController_FindPersonNotFound(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/people/99/", nil)
+	response := httptest.NewRecorder()
+
+	findCharacterControllerMock := FindCharacterControllerMock{}
+	findCharacterControllerMock.On("FindCharacter", &model.CharacterIdentifier{Id: 99}, newRequest.Context()).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Character Not Found"})
+
+	controller := NewSwapiController(&findCharacterControllerMock, &ListCharactersControllerMock{}, SwapiLinkOptions{})
+	controller.FindPerson(response, newRequest)
+
+	assert.EqualValues(t, http.StatusNotFound, response.Result().StatusCode)
+	assert.JSONEq(t, `{"detail": "Not found"}`, response.Body.String())
+}
+
+func TestSwapiController_ListPeople(t *testing.T) {
+	type testCase struct {
+		name     string
+		url      string
+		offset   int
+		total    int
+		next     *string
+		previous *string
+	}
+
+	secondPage := "http://faas.example.com/api/people/?page=2"
+	firstPage := "http://faas.example.com/api/people/?page=1"
+	thirdPage := "http://faas.example.com/api/people/?page=3"
+
+	testCases := []testCase{
+		{name: "first page", url: "http://faas.example.com/api/people/", offset: 0, total: 25, next: &secondPage},
+		{name: "middle page", url: "http://faas.example.com/api/people/?page=2", offset: 10, total: 25, next: &thirdPage, previous: &firstPage},
+		{name: "single page", url: "http://faas.example.com/api/people/", offset: 0, total: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newRequest := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			response := httptest.NewRecorder()
+
+			listControllerMock := ListCharactersControllerMock{}
+			listControllerMock.On("ListCharacters", &model.CharacterQuery{
+				Sort:   []model.SortOrder{{Field: "id"}},
+				Limit:  swapiPageSize,
+				Offset: tc.offset,
+			}, newRequest.Context()).
+				Return(&model.CharacterPage{
+					Characters: []*model.CharacterDetail{swapiCharacterDetail()},
+					Total:      tc.total,
+					Limit:      swapiPageSize,
+					Offset:     tc.offset,
+				}, nil)
+
+			controller := NewSwapiController(&FindCharacterControllerMock{}, &listControllerMock, SwapiLinkOptions{})
+			controller.ListPeople(response, newRequest)
+
+			result := controllerModel.SwapiPeopleListResponse{}
+			json.NewDecoder(response.Body).Decode(&result)
+
+			assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+			assert.EqualValues(t, tc.total, result.Count)
+			assert.Equal(t, tc.next, result.Next)
+			assert.Equal(t, tc.previous, result.Previous)
+			assert.Len(t, result.Results, 1)
+		})
+	}
+}
+
+func TestSwapiController_ListPeopleInvalidPage(t *testing.T) {
+	type testCase struct {
+		name string
+		url  string
+	}
+
+	testCases := []testCase{
+		{name: "letter page", url: "/api/people/?page=a"},
+		{name: "zero page", url: "/api/people/?page=0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newRequest := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			response := httptest.NewRecorder()
+
+			listControllerMock := ListCharactersControllerMock{}
+
+			controller := NewSwapiController(&FindCharacterControllerMock{}, &listControllerMock, SwapiLinkOptions{})
+			controller.ListPeople(response, newRequest)
+
+			assert.EqualValues(t, http.StatusNotFound, response.Result().StatusCode)
+			listControllerMock.AssertNotCalled(t, "ListCharacters", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestSwapiController_ListPeoplePageOutOfRange(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/people/?page=9", nil)
+	response := httptest.NewRecorder()
+
+	listControllerMock := ListCharactersControllerMock{}
+	listControllerMock.On("ListCharacters", mock.IsType(&model.CharacterQuery{}), newRequest.Context()).
+		Return(&model.CharacterPage{Characters: []*model.CharacterDetail{}, Total: 25, Limit: swapiPageSize, Offset: 80}, nil)
+
+	controller := NewSwapiController(&FindCharacterControllerMock{}, &listControllerMock, SwapiLinkOptions{})
+	controller.ListPeople(response, newRequest)
+
+	assert.EqualValues(t, http.StatusNotFound, response.Result().StatusCode)
+	assert.JSONEq(t, `{"detail": "Not found"}`, response.Body.String())
+}
@@ -0,0 +1,27 @@
+package controller
+
+import (
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+)
+
+// VehicleController serves /vehicles and /characters/{id}/vehicles.
+type VehicleController = LinkedResourceController[*model.Vehicle, *model.VehicleIdentifier, *model.VehicleDetail]
+
+func NewVehicleController(
+	createVehicle in.CreateLinkedResource[*model.Vehicle, *model.VehicleIdentifier],
+	findVehicle in.FindLinkedResource[*model.VehicleIdentifier, *model.VehicleDetail],
+	listCharacterVehicles in.ListCharacterLinkedResources[*model.VehicleDetail],
+	listLinkedCharacters in.ListLinkedCharacters,
+) *VehicleController {
+	return newLinkedResourceController(linkedResourceMapping[*model.Vehicle, *model.VehicleIdentifier, *model.VehicleDetail]{
+		name:           "vehicle",
+		kind:           model.LinkVehicles,
+		toDomain:       decodeLinkedResource(controllerModel.CreateVehicleRequest.ToDomain),
+		identifier:     func(id int) *model.VehicleIdentifier { return &model.VehicleIdentifier{Id: id} },
+		createResponse: asResponse(controllerModel.CreateVehicleResponseFromDomain),
+		findResponse:   asResponse(controllerModel.FindVehicleResponseFromDomain),
+		listResponse:   asResponse(controllerModel.ListVehiclesResponseFromDomain),
+	}, createVehicle, findVehicle, listCharacterVehicles, listLinkedCharacters)
+}
@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"context"
+	"github.com/graph-gophers/dataloader/v7"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"time"
+)
+
+// loaderWait is how long the loader collects keys before it queries, the fields of a
+// request are resolved in parallel so a short window is enough.
+const loaderWait = time.Millisecond
+
+type characterLoaderKey struct{}
+
+type characterLoader = dataloader.Loader[int, *model.CharacterDetail]
+
+// newCharacterLoader batches the characters requested together into one FindCharactersByIds,
+// the ones it does not find go through FindCharacter, which may import them from the source.
+func newCharacterLoader(findCharactersByIds in.FindCharactersByIds, findCharacter in.FindCharacter) *characterLoader {
+	batch := func(ctx context.Context, ids []int) []*dataloader.Result[*model.CharacterDetail] {
+		results := make([]*dataloader.Result[*model.CharacterDetail], len(ids))
+
+		identifiers := make([]*model.CharacterIdentifier, 0, len(ids))
+		for _, id := range ids {
+			identifiers = append(identifiers, &model.CharacterIdentifier{Id: id})
+		}
+
+		characters, err := findCharactersByIds.FindCharactersByIds(identifiers, ctx)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[*model.CharacterDetail]{Error: err}
+			}
+			return results
+		}
+
+		for i, characterDetail := range characters {
+			if characterDetail != nil {
+				results[i] = &dataloader.Result[*model.CharacterDetail]{Data: characterDetail}
+				continue
+			}
+
+			found, findErr := findCharacter.FindCharacter(identifiers[i], ctx)
+			results[i] = &dataloader.Result[*model.CharacterDetail]{Data: found, Error: findErr}
+		}
+
+		return results
+	}
+
+	return dataloader.NewBatchedLoader(batch, dataloader.WithWait[int, *model.CharacterDetail](loaderWait))
+}
+
+// withCharacterLoader gives every request its own loader, so nothing is cached across requests.
+func withCharacterLoader(ctx context.Context, loader *characterLoader) context.Context {
+	return context.WithValue(ctx, characterLoaderKey{}, loader)
+}
+
+func characterLoaderFrom(ctx context.Context) *characterLoader {
+	return ctx.Value(characterLoaderKey{}).(*characterLoader)
+}
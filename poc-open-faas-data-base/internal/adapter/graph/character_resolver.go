@@ -0,0 +1,170 @@
+package graph
+
+import (
+	"github.com/graph-gophers/graphql-go"
+	"handler/function/internal/application/model"
+	"strconv"
+	"time"
+)
+
+type characterResolver struct {
+	characterDetail *model.CharacterDetail
+}
+
+func (r *characterResolver) ID() graphql.ID {
+	return graphql.ID(strconv.Itoa(r.characterDetail.Id.Id))
+}
+
+func (r *characterResolver) Name() string {
+	return r.characterDetail.Character.Name
+}
+
+func (r *characterResolver) Height() string {
+	return r.characterDetail.Character.Height.String()
+}
+
+func (r *characterResolver) Mass() string {
+	return r.characterDetail.Character.Mass.String()
+}
+
+func (r *characterResolver) HairColor() string {
+	return r.characterDetail.Character.HairColor
+}
+
+func (r *characterResolver) SkinColor() string {
+	return r.characterDetail.Character.SkinColor
+}
+
+func (r *characterResolver) EyeColor() string {
+	return r.characterDetail.Character.EyeColor
+}
+
+func (r *characterResolver) BirthYear() string {
+	return r.characterDetail.Character.BirthYear.String()
+}
+
+func (r *characterResolver) Gender() string {
+	return r.characterDetail.Character.Gender
+}
+
+func (r *characterResolver) Homeworld() string {
+	return r.characterDetail.Character.Homeworld
+}
+
+func (r *characterResolver) HomeworldId() *int32 {
+	if r.characterDetail.Character.HomeworldId == nil {
+		return nil
+	}
+	homeworldId := int32(*r.characterDetail.Character.HomeworldId)
+	return &homeworldId
+}
+
+func (r *characterResolver) Films() []*linkResolver {
+	return linksFromDomain(r.characterDetail.Character.Films)
+}
+
+func (r *characterResolver) Species() []*linkResolver {
+	return linksFromDomain(r.characterDetail.Character.Species)
+}
+
+func (r *characterResolver) Vehicles() []*linkResolver {
+	return linksFromDomain(r.characterDetail.Character.Vehicles)
+}
+
+func (r *characterResolver) Starships() []*linkResolver {
+	return linksFromDomain(r.characterDetail.Character.Starships)
+}
+
+func (r *characterResolver) Created() string {
+	return r.characterDetail.Character.Created.UTC().Format(time.RFC3339Nano)
+}
+
+func (r *characterResolver) Edited() string {
+	return r.characterDetail.Character.Edited.UTC().Format(time.RFC3339Nano)
+}
+
+func (r *characterResolver) Url() string {
+	return r.characterDetail.Character.Url
+}
+
+func (r *characterResolver) Version() int32 {
+	return int32(r.characterDetail.Version)
+}
+
+type linkResolver struct {
+	link model.ResourceLink
+}
+
+func (r *linkResolver) ID() int32 {
+	return int32(r.link.Id)
+}
+
+func (r *linkResolver) Url() string {
+	return r.link.Url
+}
+
+func linksFromDomain(resourceLinks []model.ResourceLink) []*linkResolver {
+	links := make([]*linkResolver, 0, len(resourceLinks))
+	for _, resourceLink := range resourceLinks {
+		links = append(links, &linkResolver{link: resourceLink})
+	}
+	return links
+}
+
+type characterConnectionResolver struct {
+	page *model.CharacterPage
+}
+
+func (r *characterConnectionResolver) TotalCount() int32 {
+	return int32(r.page.Total)
+}
+
+func (r *characterConnectionResolver) Edges() []*characterEdgeResolver {
+	edges := make([]*characterEdgeResolver, 0, len(r.page.Characters))
+	for i, characterDetail := range r.page.Characters {
+		edges = append(edges, &characterEdgeResolver{
+			cursor:    encodeCursor(r.page.Offset + i),
+			character: &characterResolver{characterDetail: characterDetail},
+		})
+	}
+	return edges
+}
+
+func (r *characterConnectionResolver) PageInfo() *pageInfoResolver {
+	pageInfo := &pageInfoResolver{
+		hasNextPage: r.page.Offset+len(r.page.Characters) < r.page.Total,
+	}
+
+	if len(r.page.Characters) > 0 {
+		endCursor := encodeCursor(r.page.Offset + len(r.page.Characters) - 1)
+		pageInfo.endCursor = &endCursor
+	}
+
+	return pageInfo
+}
+
+type characterEdgeResolver struct {
+	cursor    string
+	character *characterResolver
+}
+
+func (r *characterEdgeResolver) Cursor() string {
+	return r.cursor
+}
+
+func (r *characterEdgeResolver) Node() *characterResolver {
+	return r.character
+}
+
+type pageInfoResolver struct {
+	hasNextPage bool
+	endCursor   *string
+}
+
+func (r *pageInfoResolver) HasNextPage() bool {
+	return r.hasNextPage
+}
+
+func (r *pageInfoResolver) EndCursor() *string {
+	return r.endCursor
+}
@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"encoding/json"
+	"github.com/graph-gophers/graphql-go"
+	"log"
+	"net/http"
+)
+
+// maxQueryDepth keeps a client from asking for arbitrarily nested selections.
+const maxQueryDepth = 10
+
+type GraphqlController struct {
+	schema   *graphql.Schema
+	resolver *Resolver
+}
+
+func NewGraphqlController(resolver *Resolver) (*GraphqlController, error) {
+	parsedSchema, err := graphql.ParseSchema(schema, resolver, graphql.MaxDepth(maxQueryDepth))
+	if err != nil {
+		return nil, err
+	}
+
+	return &GraphqlController{schema: parsedSchema, resolver: resolver}, nil
+}
+
+type graphqlRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Query answers like any GraphQL server, the errors of the resolvers travel in a 200 response
+// and only a body that is not a GraphQL request is a 400.
+func (c *GraphqlController) Query(w http.ResponseWriter, r *http.Request) {
+	request := &graphqlRequest{}
+
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil || request.Query == "" {
+		log.Printf("Error reading graphql request: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Invalid request body: a json object with a query is expected"))
+		return
+	}
+
+	ctx := withCharacterLoader(r.Context(), newCharacterLoader(c.resolver.findCharactersByIds, c.resolver.findCharacter))
+
+	response := c.schema.Exec(ctx, request.Query, request.OperationName, request.Variables)
+
+	jsonResult, jsonError := json.Marshal(response)
+	if jsonError != nil {
+		log.Printf("Error converting to json %s", jsonError.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(jsonError.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResult)
+}
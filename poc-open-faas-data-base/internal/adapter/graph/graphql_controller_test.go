@@ -0,0 +1,310 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type CreateCharacterMock struct {
+	mock.Mock
+}
+
+func (c *CreateCharacterMock) CreateCharacter(character *model.Character, ctx context.Context) (*model.CharacterIdentifier, error) {
+	args := c.Called(character, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.CharacterIdentifier), nil
+}
+
+type FindCharacterMock struct {
+	mock.Mock
+}
+
+func (f *FindCharacterMock) FindCharacter(character *model.CharacterIdentifier, ctx context.Context) (*model.CharacterDetail, error) {
+	args := f.Called(character, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.CharacterDetail), nil
+}
+
+func (f *FindCharacterMock) FindCharacterProjection(character *model.CharacterIdentifier, projection *model.CharacterProjection, ctx context.Context) (*model.CharacterDetail, error) {
+	return f.FindCharacter(character, ctx)
+}
+
+type FindCharactersByIdsMock struct {
+	mock.Mock
+}
+
+func (f *FindCharactersByIdsMock) FindCharactersByIds(characters []*model.CharacterIdentifier, ctx context.Context) ([]*model.CharacterDetail, error) {
+	args := f.Called(characters, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	if answer, ok := args.Get(0).(func([]*model.CharacterIdentifier) []*model.CharacterDetail); ok {
+		return answer(characters), nil
+	}
+
+	return args.Get(0).([]*model.CharacterDetail), nil
+}
+
+type ListCharactersMock struct {
+	mock.Mock
+}
+
+func (l *ListCharactersMock) ListCharacters(query *model.CharacterQuery, ctx context.Context) (*model.CharacterPage, error) {
+	args := l.Called(query, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.CharacterPage), nil
+}
+
+var characterHeight = 202.0
+
+func characterDetail(id int, name string) *model.CharacterDetail {
+	return &model.CharacterDetail{
+		Id: &model.CharacterIdentifier{Id: id},
+		Character: &model.Character{
+			Name:    name,
+			Height:  model.NewMeasure(&characterHeight, model.HeightUnit),
+			Films:   []model.ResourceLink{{Id: 1, Url: "https://swapi.dev/api/films/1/"}},
+			Created: time.Date(2014, 12, 10, 15, 18, 20, 704000000, time.UTC),
+		},
+		Version: 1,
+	}
+}
+
+type graphqlResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func execute(t *testing.T, resolver *Resolver, query string, variables map[string]any) (int, *graphqlResponse) {
+	controller, err := NewGraphqlController(resolver)
+	assert.Nil(t, err)
+
+	body, _ := json.Marshal(map[string]any{"query": query, "variables": variables})
+	newRequest := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+
+	response := httptest.NewRecorder()
+
+	controller.Query(response, newRequest)
+
+	graphql := &graphqlResponse{}
+	json.NewDecoder(response.Result().Body).Decode(graphql)
+
+	return response.Result().StatusCode, graphql
+}
+
+func TestGraphqlController_CharactersAreBatched(t *testing.T) {
+
+	characters := map[int]*model.CharacterDetail{
+		1: characterDetail(1, "Luke Skywalker"),
+		4: characterDetail(4, "Darth Vader"),
+	}
+
+	findByIdsMock := FindCharactersByIdsMock{}
+	// the fields are resolved concurrently, the batch answers in the order its keys were collected.
+	findByIdsMock.On("FindCharactersByIds", mock.MatchedBy(func(identifiers []*model.CharacterIdentifier) bool {
+		return len(identifiers) == 3
+	}), mock.Anything).
+		Return(func(identifiers []*model.CharacterIdentifier) []*model.CharacterDetail {
+			results := make([]*model.CharacterDetail, 0, len(identifiers))
+			for _, identifier := range identifiers {
+				results = append(results, characters[identifier.Id])
+			}
+			return results
+		}, nil)
+
+	findMock := FindCharacterMock{}
+	findMock.On("FindCharacter", &model.CharacterIdentifier{Id: 99}, mock.Anything).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Character not found"})
+
+	resolver := NewResolver(&CreateCharacterMock{}, &findMock, &findByIdsMock, &ListCharactersMock{})
+
+	statusCode, response := execute(t, resolver, `{
+		luke: character(id: "1") { name }
+		vader: character(id: "4") { name height films { id } }
+		missing: character(id: "99") { name }
+	}`, nil)
+
+	assert.EqualValues(t, http.StatusOK, statusCode)
+	assert.JSONEq(t, `{"name": "Luke Skywalker"}`, string(response.Data["luke"]))
+	assert.JSONEq(t, `{"name": "Darth Vader", "height": "202", "films": [{"id": 1}]}`, string(response.Data["vader"]))
+	assert.JSONEq(t, `null`, string(response.Data["missing"]))
+	assert.Len(t, response.Errors, 1)
+	assert.EqualValues(t, "Character not found", response.Errors[0].Message)
+	assert.EqualValues(t, "NOT_FOUND", response.Errors[0].Extensions["code"])
+	findByIdsMock.AssertNumberOfCalls(t, "FindCharactersByIds", 1)
+	findMock.AssertNumberOfCalls(t, "FindCharacter", 1)
+}
+
+func TestGraphqlController_Characters(t *testing.T) {
+
+	listMock := ListCharactersMock{}
+	listMock.On("ListCharacters", mock.MatchedBy(func(q *model.CharacterQuery) bool {
+		return q.Limit == 2 && q.Offset == 2 && q.Filter != nil
+	}), mock.Anything).
+		Return(&model.CharacterPage{
+			Characters: []*model.CharacterDetail{characterDetail(3, "R2-D2"), characterDetail(4, "Darth Vader")},
+			Total:      5,
+			Limit:      2,
+			Offset:     2,
+		}, nil)
+
+	resolver := NewResolver(&CreateCharacterMock{}, &FindCharacterMock{}, &FindCharactersByIdsMock{}, &listMock)
+
+	statusCode, response := execute(t, resolver, `query ($after: String) {
+		characters(filter: "height gt 100", first: 2, after: $after) {
+			totalCount
+			edges { cursor node { id name } }
+			pageInfo { hasNextPage endCursor }
+		}
+	}`, map[string]any{"after": encodeCursor(1)})
+
+	assert.EqualValues(t, http.StatusOK, statusCode)
+	assert.Empty(t, response.Errors)
+	assert.JSONEq(t, `{
+		"totalCount": 5,
+		"edges": [
+			{"cursor": "`+encodeCursor(2)+`", "node": {"id": "3", "name": "R2-D2"}},
+			{"cursor": "`+encodeCursor(3)+`", "node": {"id": "4", "name": "Darth Vader"}}
+		],
+		"pageInfo": {"hasNextPage": true, "endCursor": "`+encodeCursor(3)+`"}
+	}`, string(response.Data["characters"]))
+}
+
+func TestGraphqlController_CharactersFirstZero(t *testing.T) {
+
+	listMock := ListCharactersMock{}
+	listMock.On("ListCharacters", mock.MatchedBy(func(q *model.CharacterQuery) bool {
+		return q.Limit == 1 && q.Offset == 0
+	}), mock.Anything).
+		Return(&model.CharacterPage{
+			Characters: []*model.CharacterDetail{characterDetail(1, "Luke Skywalker")},
+			Total:      5,
+			Limit:      1,
+		}, nil)
+
+	resolver := NewResolver(&CreateCharacterMock{}, &FindCharacterMock{}, &FindCharactersByIdsMock{}, &listMock)
+
+	statusCode, response := execute(t, resolver, `{
+		characters(first: 0) {
+			totalCount
+			edges { cursor }
+			pageInfo { hasNextPage endCursor }
+		}
+	}`, nil)
+
+	assert.EqualValues(t, http.StatusOK, statusCode)
+	assert.Empty(t, response.Errors)
+	assert.JSONEq(t, `{
+		"totalCount": 5,
+		"edges": [],
+		"pageInfo": {"hasNextPage": true, "endCursor": null}
+	}`, string(response.Data["characters"]))
+}
+
+func TestGraphqlController_CharactersInvalidArguments(t *testing.T) {
+
+	type testCase struct {
+		name  string
+		query string
+		msj   string
+	}
+
+	testCases := []testCase{
+		{name: "filter", query: `{ characters(filter: "gender gt \"male\"") { totalCount } }`, msj: "Invalid filter at position"},
+		{name: "cursor", query: `{ characters(after: "nope") { totalCount } }`, msj: "Invalid cursor"},
+		{name: "id", query: `{ character(id: "a") { name } }`, msj: "Invalid character identifier"},
+	}
+
+	resolver := NewResolver(&CreateCharacterMock{}, &FindCharacterMock{}, &FindCharactersByIdsMock{}, &ListCharactersMock{})
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			statusCode, response := execute(t, resolver, test.query, nil)
+
+			assert.EqualValues(t, http.StatusOK, statusCode)
+			assert.Len(t, response.Errors, 1)
+			assert.True(t, strings.HasPrefix(response.Errors[0].Message, test.msj), response.Errors[0].Message)
+			assert.EqualValues(t, "BAD_REQUEST", response.Errors[0].Extensions["code"])
+		})
+	}
+}
+
+func TestGraphqlController_CreateCharacter(t *testing.T) {
+
+	createMock := CreateCharacterMock{}
+	createMock.On("CreateCharacter", mock.MatchedBy(func(c *model.Character) bool {
+		return c.Name == "Darth Vader" && *c.Height.Value == 202 && c.Mass.Value == nil &&
+			len(c.Films) == 1 && c.Films[0].Url == "https://swapi.dev/api/films/1/"
+	}), mock.Anything).Return(&model.CharacterIdentifier{Id: 4}, nil)
+
+	findByIdsMock := FindCharactersByIdsMock{}
+	findByIdsMock.On("FindCharactersByIds", []*model.CharacterIdentifier{{Id: 4}}, mock.Anything).
+		Return([]*model.CharacterDetail{characterDetail(4, "Darth Vader")}, nil)
+
+	resolver := NewResolver(&createMock, &FindCharacterMock{}, &findByIdsMock, &ListCharactersMock{})
+
+	statusCode, response := execute(t, resolver, `mutation {
+		createCharacter(input: {name: "Darth Vader", height: "202", mass: "unknown", films: [{url: "https://swapi.dev/api/films/1/"}]}) {
+			id
+			version
+		}
+	}`, nil)
+
+	assert.EqualValues(t, http.StatusOK, statusCode)
+	assert.Empty(t, response.Errors)
+	assert.JSONEq(t, `{"id": "4", "version": 1}`, string(response.Data["createCharacter"]))
+}
+
+func TestGraphqlController_CreateCharacterInvalidMass(t *testing.T) {
+
+	createMock := CreateCharacterMock{}
+
+	resolver := NewResolver(&createMock, &FindCharacterMock{}, &FindCharactersByIdsMock{}, &ListCharactersMock{})
+
+	_, response := execute(t, resolver, `mutation { createCharacter(input: {name: "Darth Vader", mass: "heavy"}) { id } }`, nil)
+
+	assert.Len(t, response.Errors, 1)
+	assert.EqualValues(t, "BAD_REQUEST", response.Errors[0].Extensions["code"])
+	createMock.AssertNotCalled(t, "CreateCharacter", mock.Anything, mock.Anything)
+}
+
+func TestGraphqlController_QueryInvalidBody(t *testing.T) {
+
+	controller, err := NewGraphqlController(NewResolver(&CreateCharacterMock{}, &FindCharacterMock{}, &FindCharactersByIdsMock{}, &ListCharactersMock{}))
+	assert.Nil(t, err)
+
+	newRequest := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"variables": {}}`))
+
+	response := httptest.NewRecorder()
+
+	controller.Query(response, newRequest)
+
+	assert.EqualValues(t, http.StatusBadRequest, response.Result().StatusCode)
+}
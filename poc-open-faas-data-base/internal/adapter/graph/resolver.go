@@ -0,0 +1,265 @@
+package graph
+
+import (
+	"context"
+	_ "embed"
+	"encoding/base64"
+	"fmt"
+	"github.com/graph-gophers/graphql-go"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/pkg"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+//go:embed schema.graphql
+var schema string
+
+const cursorPrefix = "offset:"
+
+// Resolver is the root of the schema, queries and mutations call the same ports as the HTTP controllers.
+type Resolver struct {
+	createCharacter     in.CreateCharacter
+	findCharacter       in.FindCharacter
+	findCharactersByIds in.FindCharactersByIds
+	listCharacters      in.ListCharacters
+}
+
+func NewResolver(
+	createCharacter in.CreateCharacter,
+	findCharacter in.FindCharacter,
+	findCharactersByIds in.FindCharactersByIds,
+	listCharacters in.ListCharacters,
+) *Resolver {
+	return &Resolver{
+		createCharacter:     createCharacter,
+		findCharacter:       findCharacter,
+		findCharactersByIds: findCharactersByIds,
+		listCharacters:      listCharacters,
+	}
+}
+
+func (r *Resolver) Character(ctx context.Context, args struct{ ID graphql.ID }) (*characterResolver, error) {
+	id, err := strconv.Atoi(string(args.ID))
+	if err != nil || id <= 0 {
+		return nil, toGraphqlError(badRequest("Invalid character identifier"))
+	}
+
+	characterDetail, err := characterLoaderFrom(ctx).Load(ctx, id)()
+	if err != nil {
+		return nil, toGraphqlError(err)
+	}
+
+	return &characterResolver{characterDetail: characterDetail}, nil
+}
+
+type charactersArgs struct {
+	Filter *string
+	First  int32
+	After  *string
+}
+
+func (r *Resolver) Characters(ctx context.Context, args charactersArgs) (*characterConnectionResolver, error) {
+	query := &model.CharacterQuery{}
+
+	if args.First < 0 {
+		return nil, toGraphqlError(badRequest("first must not be negative"))
+	}
+	query.Limit = int(args.First)
+
+	// first: 0 only asks for the total, a zero limit is the default page so a single character is read and dropped.
+	countOnly := args.First == 0
+	if countOnly {
+		query.Limit = 1
+	}
+
+	if args.After != nil {
+		offset, err := decodeCursor(*args.After)
+		if err != nil {
+			return nil, toGraphqlError(err)
+		}
+		query.Offset = offset + 1
+	}
+
+	if args.Filter != nil && *args.Filter != "" {
+		filter, err := model.ParseFilter(*args.Filter)
+		if err != nil {
+			return nil, toGraphqlError(badRequest(fmt.Sprintf("Invalid filter at %s", err.Error())))
+		}
+		query.Filter = filter
+	}
+
+	page, err := r.listCharacters.ListCharacters(query, ctx)
+	if err != nil {
+		return nil, toGraphqlError(err)
+	}
+
+	if countOnly {
+		page.Characters, page.Limit = []*model.CharacterDetail{}, 0
+	}
+
+	// the listed characters are already loaded, a character(id) in the same request reuses them.
+	loader := characterLoaderFrom(ctx)
+	for _, characterDetail := range page.Characters {
+		loader.Prime(ctx, characterDetail.Id.Id, characterDetail)
+	}
+
+	return &characterConnectionResolver{page: page}, nil
+}
+
+type linkInput struct {
+	Id  *int32
+	Url *string
+}
+
+type createCharacterInput struct {
+	Name        string
+	Height      *string
+	Mass        *string
+	HairColor   *string
+	SkinColor   *string
+	EyeColor    *string
+	BirthYear   *string
+	Gender      *string
+	Homeworld   *string
+	HomeworldId *int32
+	Films       *[]linkInput
+	Species     *[]linkInput
+	Vehicles    *[]linkInput
+	Starships   *[]linkInput
+	Url         *string
+}
+
+func (r *Resolver) CreateCharacter(ctx context.Context, args struct{ Input createCharacterInput }) (*characterResolver, error) {
+	character, err := characterToDomain(args.Input)
+	if err != nil {
+		return nil, toGraphqlError(err)
+	}
+
+	characterIdentifier, err := r.createCharacter.CreateCharacter(character, ctx)
+	if err != nil {
+		return nil, toGraphqlError(err)
+	}
+
+	characterDetail, err := characterLoaderFrom(ctx).Load(ctx, characterIdentifier.Id)()
+	if err != nil {
+		return nil, toGraphqlError(err)
+	}
+
+	return &characterResolver{characterDetail: characterDetail}, nil
+}
+
+func characterToDomain(input createCharacterInput) (*model.Character, error) {
+	height, err := model.ParseHeight(stringValue(input.Height))
+	if err != nil {
+		return nil, badRequest(err.Error())
+	}
+
+	mass, err := model.ParseMass(stringValue(input.Mass))
+	if err != nil {
+		return nil, badRequest(err.Error())
+	}
+
+	birthYear, err := model.ParseBirthYear(stringValue(input.BirthYear))
+	if err != nil {
+		return nil, badRequest(err.Error())
+	}
+
+	var homeworldId *int
+	if input.HomeworldId != nil {
+		id := int(*input.HomeworldId)
+		homeworldId = &id
+	}
+
+	return &model.Character{
+		Name:        input.Name,
+		Height:      height,
+		Mass:        mass,
+		HairColor:   stringValue(input.HairColor),
+		SkinColor:   stringValue(input.SkinColor),
+		EyeColor:    stringValue(input.EyeColor),
+		BirthYear:   birthYear,
+		Gender:      stringValue(input.Gender),
+		Homeworld:   stringValue(input.Homeworld),
+		HomeworldId: homeworldId,
+		Url:         stringValue(input.Url),
+		Films:       linksToDomain(input.Films),
+		Species:     linksToDomain(input.Species),
+		Vehicles:    linksToDomain(input.Vehicles),
+		Starships:   linksToDomain(input.Starships),
+	}, nil
+}
+
+func linksToDomain(links *[]linkInput) []model.ResourceLink {
+	if links == nil || len(*links) == 0 {
+		return nil
+	}
+
+	resourceLinks := make([]model.ResourceLink, 0, len(*links))
+	for _, link := range *links {
+		resourceLink := model.ResourceLink{Url: stringValue(link.Url)}
+		if link.Id != nil {
+			resourceLink.Id = int(*link.Id)
+		}
+		resourceLinks = append(resourceLinks, resourceLink)
+	}
+
+	return resourceLinks
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// the cursor of an edge is its opaque position in the listing, after continues right behind it.
+func encodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(decoded), cursorPrefix) {
+		return 0, badRequest("Invalid cursor")
+	}
+
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(decoded), cursorPrefix))
+	if err != nil || offset < 0 {
+		return 0, badRequest("Invalid cursor")
+	}
+
+	return offset, nil
+}
+
+func badRequest(msj string) error {
+	return pkg.GenericException{
+		StatusCode: http.StatusBadRequest,
+		Msj:        msj,
+	}
+}
+
+// graphqlError keeps the status of the use case error in the extensions of the response.
+type graphqlError struct {
+	statusCode int
+	msj        string
+}
+
+func (e graphqlError) Error() string {
+	return e.msj
+}
+
+func (e graphqlError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":   strings.ToUpper(strings.ReplaceAll(http.StatusText(e.statusCode), " ", "_")),
+		"status": e.statusCode,
+	}
+}
+
+func toGraphqlError(err error) error {
+	statusCode, errorMsj := pkg.GetErrorDetail(err)
+	return graphqlError{statusCode: statusCode, msj: strings.TrimSpace(errorMsj)}
+}
@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	cacheModel "handler/function/internal/adapter/chache/model"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var _ cacheModel.CacheObserver = (*Metrics)(nil)
+var _ repositoryModel.QueryObserver = (*Metrics)(nil)
+
+// Metrics has the collectors of the function, exposed in the Prometheus text format by Handler.
+type Metrics struct {
+	registry        *prometheus.Registry
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	cacheRequests   *prometheus.CounterVec
+	queryDuration   *prometheus.HistogramVec
+	queryErrors     *prometheus.CounterVec
+	pool            *poolCollector
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "HTTP requests by route, method and status.",
+		}, []string{"route", "method", "status"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latency by route, method and status.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"route", "method", "status"}),
+		cacheRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "cache_requests_total",
+			Help: "Cache requests by cache, operation and result: hit, miss or error for reads, ok or error for writes.",
+		}, []string{"cache", "operation", "result"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "database_query_duration_seconds",
+			Help:    "Database query latency by repository operation.",
+			Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"operation"}),
+		queryErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "database_query_errors_total",
+			Help: "Database queries that failed by repository operation.",
+		}, []string{"operation"}),
+		pool: &poolCollector{},
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests,
+		m.requestDuration,
+		m.cacheRequests,
+		m.queryDuration,
+		m.queryErrors,
+		m.pool,
+	)
+
+	return m
+}
+
+// Handler serves the metrics in the Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveRequest records a request answered by route, the route is the registered one and not the path,
+// so the identifiers in the paths do not create a series each.
+func (m *Metrics) ObserveRequest(route string, method string, status int, duration time.Duration) {
+	labels := prometheus.Labels{"route": route, "method": method, "status": strconv.Itoa(status)}
+	m.requests.With(labels).Inc()
+	m.requestDuration.With(labels).Observe(duration.Seconds())
+}
+
+func (m *Metrics) ObserveCache(cache string, operation string, result string) {
+	m.cacheRequests.WithLabelValues(cache, operation, result).Inc()
+}
+
+func (m *Metrics) ObserveQuery(operation string, duration time.Duration, err error) {
+	m.queryDuration.WithLabelValues(operation).Observe(duration.Seconds())
+	if err != nil {
+		m.queryErrors.WithLabelValues(operation).Inc()
+	}
+}
+
+// ObservePool exposes the statistics of the pool, the initialization sets it once it succeeds.
+func (m *Metrics) ObservePool(pool *pgxpool.Pool) {
+	m.pool.setPool(pool)
+}
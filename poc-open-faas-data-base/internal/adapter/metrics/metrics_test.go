@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestObserveRequest(t *testing.T) {
+	m := NewMetrics()
+
+	m.ObserveRequest("GET /characters/", http.MethodGet, http.StatusOK, 20*time.Millisecond)
+	m.ObserveRequest("GET /characters/", http.MethodGet, http.StatusOK, 40*time.Millisecond)
+	m.ObserveRequest("GET /characters/", http.MethodGet, http.StatusNotFound, time.Millisecond)
+
+	assert.EqualValues(t, 2, testutil.ToFloat64(m.requests.WithLabelValues("GET /characters/", http.MethodGet, "200")))
+	assert.EqualValues(t, 1, testutil.ToFloat64(m.requests.WithLabelValues("GET /characters/", http.MethodGet, "404")))
+	assert.EqualValues(t, 2, testutil.CollectAndCount(m.requestDuration))
+}
+
+func TestObserveCache(t *testing.T) {
+	m := NewMetrics()
+
+	m.ObserveCache("character", "find", "hit")
+	m.ObserveCache("character", "find", "hit")
+	m.ObserveCache("character", "find", "miss")
+
+	assert.EqualValues(t, 2, testutil.ToFloat64(m.cacheRequests.WithLabelValues("character", "find", "hit")))
+	assert.EqualValues(t, 1, testutil.ToFloat64(m.cacheRequests.WithLabelValues("character", "find", "miss")))
+}
+
+func TestObserveQuery(t *testing.T) {
+	m := NewMetrics()
+
+	m.ObserveQuery("find_character", 3*time.Millisecond, nil)
+	m.ObserveQuery("find_character", 5*time.Millisecond, errors.New("connection reset"))
+
+	assert.EqualValues(t, 1, testutil.CollectAndCount(m.queryDuration))
+	assert.EqualValues(t, 1, testutil.ToFloat64(m.queryErrors.WithLabelValues("find_character")))
+}
+
+func TestHandler(t *testing.T) {
+	m := NewMetrics()
+	m.ObserveCache("character", "find", "miss")
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body, _ := io.ReadAll(rec.Result().Body)
+
+	assert.EqualValues(t, http.StatusOK, rec.Result().StatusCode)
+	assert.True(t, strings.HasPrefix(rec.Result().Header.Get("Content-Type"), "text/plain"))
+	assert.Contains(t, string(body), `cache_requests_total{cache="character",operation="find",result="miss"} 1`)
+	assert.Contains(t, string(body), "go_goroutines")
+	// the pool gauges are collected once the initialization sets the pool.
+	assert.NotContains(t, string(body), "database_pool_")
+}
+
+func TestObservePool(t *testing.T) {
+	m := NewMetrics()
+
+	// the pool connects lazily, the statistics are read without a database.
+	pool, err := pgxpool.New(context.Background(), "postgres://localhost:1/starwar?pool_max_conns=4")
+	assert.Nil(t, err)
+	defer pool.Close()
+
+	m.ObservePool(pool)
+
+	assert.EqualValues(t, 9, testutil.CollectAndCount(m.pool))
+	assert.Nil(t, testutil.CollectAndCompare(m.pool, strings.NewReader(`
+# HELP database_pool_max_connections Most connections the pool opens.
+# TYPE database_pool_max_connections gauge
+database_pool_max_connections 4
+# HELP database_pool_total_connections Connections open or being opened.
+# TYPE database_pool_total_connections gauge
+database_pool_total_connections 0
+`), "database_pool_max_connections", "database_pool_total_connections"))
+}
@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"sync"
+)
+
+var (
+	poolAcquiredConns = prometheus.NewDesc("database_pool_acquired_connections",
+		"Connections in use.", nil, nil)
+	poolIdleConns = prometheus.NewDesc("database_pool_idle_connections",
+		"Connections waiting to be acquired.", nil, nil)
+	poolConstructingConns = prometheus.NewDesc("database_pool_constructing_connections",
+		"Connections being opened.", nil, nil)
+	poolTotalConns = prometheus.NewDesc("database_pool_total_connections",
+		"Connections open or being opened.", nil, nil)
+	poolMaxConns = prometheus.NewDesc("database_pool_max_connections",
+		"Most connections the pool opens.", nil, nil)
+	poolAcquires = prometheus.NewDesc("database_pool_acquires_total",
+		"Connections acquired from the pool.", nil, nil)
+	poolEmptyAcquires = prometheus.NewDesc("database_pool_empty_acquires_total",
+		"Acquires that waited for a connection because none was idle.", nil, nil)
+	poolCanceledAcquires = prometheus.NewDesc("database_pool_canceled_acquires_total",
+		"Acquires canceled by their context.", nil, nil)
+	poolAcquireDuration = prometheus.NewDesc("database_pool_acquire_duration_seconds_total",
+		"Time spent acquiring connections.", nil, nil)
+)
+
+// poolCollector reads the statistics of the pgx pool on every scrape, nothing is collected before the pool is set.
+type poolCollector struct {
+	mutex sync.RWMutex
+	pool  *pgxpool.Pool
+}
+
+func (c *poolCollector) setPool(pool *pgxpool.Pool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.pool = pool
+}
+
+func (c *poolCollector) Describe(descs chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		poolAcquiredConns, poolIdleConns, poolConstructingConns, poolTotalConns, poolMaxConns,
+		poolAcquires, poolEmptyAcquires, poolCanceledAcquires, poolAcquireDuration,
+	} {
+		descs <- desc
+	}
+}
+
+func (c *poolCollector) Collect(metrics chan<- prometheus.Metric) {
+	c.mutex.RLock()
+	pool := c.pool
+	c.mutex.RUnlock()
+
+	if pool == nil {
+		return
+	}
+
+	stat := pool.Stat()
+	metrics <- prometheus.MustNewConstMetric(poolAcquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	metrics <- prometheus.MustNewConstMetric(poolIdleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	metrics <- prometheus.MustNewConstMetric(poolConstructingConns, prometheus.GaugeValue, float64(stat.ConstructingConns()))
+	metrics <- prometheus.MustNewConstMetric(poolTotalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	metrics <- prometheus.MustNewConstMetric(poolMaxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	metrics <- prometheus.MustNewConstMetric(poolAcquires, prometheus.CounterValue, float64(stat.AcquireCount()))
+	metrics <- prometheus.MustNewConstMetric(poolEmptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	metrics <- prometheus.MustNewConstMetric(poolCanceledAcquires, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	metrics <- prometheus.MustNewConstMetric(poolAcquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+}
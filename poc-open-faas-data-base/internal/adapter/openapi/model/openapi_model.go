@@ -0,0 +1,7 @@
+package model
+
+type ValidatorOptions struct {
+	Requests bool
+	// Responses buffers every response to validate it, it is meant for tests and staging.
+	Responses bool
+}
@@ -0,0 +1,24 @@
+package openapi
+
+import (
+	"context"
+	_ "embed"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+//go:embed openapi.json
+var document []byte
+
+// LoadSpecification parses the embedded document and checks it is a valid OpenAPI 3 specification.
+func LoadSpecification(ctx context.Context) (*openapi3.T, error) {
+	specification, err := openapi3.NewLoader().LoadFromData(document)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := specification.Validate(ctx); err != nil {
+		return nil, err
+	}
+
+	return specification, nil
+}
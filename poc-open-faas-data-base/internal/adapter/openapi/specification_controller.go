@@ -0,0 +1,20 @@
+package openapi
+
+import (
+	"net/http"
+)
+
+type SpecificationController struct {
+	document []byte
+}
+
+func NewSpecificationController() *SpecificationController {
+	return &SpecificationController{document: document}
+}
+
+// FindSpecification serves the document as is, it is the contract the client SDKs are generated from.
+func (c *SpecificationController) FindSpecification(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(c.document)
+}
@@ -0,0 +1,25 @@
+package openapi
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSpecificationController_FindSpecification(t *testing.T) {
+	newRequest := httptest.NewRequest(http.MethodGet, "/openapi.json", nil)
+
+	response := httptest.NewRecorder()
+
+	NewSpecificationController().FindSpecification(response, newRequest)
+
+	body := map[string]any{}
+	err := json.NewDecoder(response.Result().Body).Decode(&body)
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+	assert.EqualValues(t, "application/json", response.Result().Header.Get("Content-Type"))
+	assert.EqualValues(t, "3.0.3", body["openapi"])
+}
@@ -0,0 +1,67 @@
+package openapi
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	controllerModel "handler/function/internal/adapter/controller/model"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestLoadSpecification(t *testing.T) {
+	specification, err := LoadSpecification(context.Background())
+
+	assert.Nil(t, err)
+	assert.NotNil(t, specification.Paths.Find("/api/v1/starwar/characters"))
+	assert.NotNil(t, specification.Paths.Find("/api/v1/starwar/characters/{id}"))
+}
+
+// TestSpecificationMatchesModels fails when a field is added to, or removed from, a model without the document.
+func TestSpecificationMatchesModels(t *testing.T) {
+
+	type testCase struct {
+		schema string
+		model  any
+	}
+
+	testCases := []testCase{
+		{schema: "CreateCharacterRequest", model: controllerModel.CreaterCharacterRequest{}},
+		{schema: "CreateCharacterResponse", model: controllerModel.CreateCharacterResponse{}},
+		{schema: "Character", model: controllerModel.FindCharacterRequest{}},
+		{schema: "CharacterList", model: controllerModel.ListCharactersResponse{}},
+		{schema: "Link", model: controllerModel.LinkResponse{}},
+		{schema: "Planet", model: controllerModel.FindPlanetResponse{}},
+	}
+
+	specification, err := LoadSpecification(context.Background())
+	assert.Nil(t, err)
+
+	for _, test := range testCases {
+		t.Run(test.schema, func(t *testing.T) {
+			schema, ok := specification.Components.Schemas[test.schema]
+			assert.True(t, ok)
+
+			properties := []string{}
+			for property := range schema.Value.Properties {
+				properties = append(properties, property)
+			}
+			sort.Strings(properties)
+
+			assert.EqualValues(t, jsonFields(reflect.TypeOf(test.model)), properties)
+		})
+	}
+}
+
+func jsonFields(modelType reflect.Type) []string {
+	fields := []string{}
+	for i := 0; i < modelType.NumField(); i++ {
+		name := strings.Split(modelType.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,151 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	openapiModel "handler/function/internal/adapter/openapi/model"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+)
+
+// the specification describes the json representation, other media types are not validated.
+var validatedMediaTypes = map[string]bool{
+	"application/json": true,
+	"text/plain":       true,
+}
+
+// Validator checks the requests, and optionally the responses, of the routes in the specification.
+type Validator struct {
+	router  routers.Router
+	options *openapiModel.ValidatorOptions
+}
+
+func NewValidator(o *openapiModel.ValidatorOptions, ctx context.Context) (*Validator, error) {
+	specification, err := LoadSpecification(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	router, err := gorillamux.NewRouter(specification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Validator{router: router, options: o}, nil
+}
+
+// Validated answers 400 to a request that does not follow the specification before the handler runs.
+// A response that does not follow it is replaced by a 500, so the drift fails the test that caught it.
+// Routes that are not in the specification are served without validation.
+func (v *Validator) Validated(handler http.HandlerFunc) http.HandlerFunc {
+	if !v.options.Requests && !v.options.Responses {
+		return handler
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, routeErr := v.router.FindRoute(r)
+		if routeErr != nil {
+			handler(w, r)
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				ExcludeRequestBody: !isValidatedMediaType(r.Header.Get("Content-Type")),
+				AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			},
+		}
+
+		if v.options.Requests {
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(fmt.Sprintf("Invalid request: %s", err.Error())))
+				return
+			}
+		}
+
+		if !v.options.Responses {
+			handler(w, r)
+			return
+		}
+
+		recorder := newResponseRecorder()
+		handler(recorder, r)
+
+		if err := validateResponse(input, recorder, r.Context()); err != nil {
+			log.Printf("Response of %s %s does not match the specification %s\n", r.Method, r.URL.Path, err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("Response does not match the specification: %s", err.Error())))
+			return
+		}
+
+		recorder.writeTo(w)
+	}
+}
+
+func validateResponse(input *openapi3filter.RequestValidationInput, recorder *responseRecorder, ctx context.Context) error {
+	// net/http sniffs the content type of a body written without one, as the error messages are.
+	if recorder.header.Get("Content-Type") == "" && recorder.body.Len() > 0 {
+		recorder.header.Set("Content-Type", http.DetectContentType(recorder.body.Bytes()))
+	}
+
+	if recorder.body.Len() > 0 && !isValidatedMediaType(recorder.header.Get("Content-Type")) {
+		return nil
+	}
+
+	return openapi3filter.ValidateResponse(ctx, &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 recorder.statusCode,
+		Header:                 recorder.header,
+		Body:                   io.NopCloser(bytes.NewReader(recorder.body.Bytes())),
+	})
+}
+
+func isValidatedMediaType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && validatedMediaTypes[mediaType]
+}
+
+// responseRecorder keeps the response until it is validated.
+type responseRecorder struct {
+	header     http.Header
+	statusCode int
+	body       *bytes.Buffer
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{header: http.Header{}, statusCode: http.StatusOK, body: &bytes.Buffer{}}
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
+
+func (r *responseRecorder) writeTo(w http.ResponseWriter) {
+	for name, values := range r.header {
+		w.Header()[name] = values
+	}
+	w.WriteHeader(r.statusCode)
+	w.Write(r.body.Bytes())
+}
@@ -0,0 +1,131 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	openapiModel "handler/function/internal/adapter/openapi/model"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newValidator(t *testing.T, options *openapiModel.ValidatorOptions) *Validator {
+	validator, err := NewValidator(options, context.Background())
+	assert.Nil(t, err)
+	return validator
+}
+
+func answer(statusCode int, contentType string, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if contentType != "" {
+			w.Header().Set("Content-Type", contentType)
+		}
+		w.WriteHeader(statusCode)
+		w.Write([]byte(body))
+	}
+}
+
+func TestValidator_ValidatedRequests(t *testing.T) {
+
+	type testCase struct {
+		name        string
+		method      string
+		url         string
+		contentType string
+		body        string
+		statusCode  int
+	}
+
+	testCases := []testCase{
+		{name: "valid listing", method: http.MethodGet, url: "/api/v1/starwar/characters?limit=10&sort=-height", statusCode: http.StatusOK},
+		{name: "invalid limit", method: http.MethodGet, url: "/api/v1/starwar/characters?limit=ten", statusCode: http.StatusBadRequest},
+		{name: "invalid expand", method: http.MethodGet, url: "/api/v1/starwar/characters/1?expand=films", statusCode: http.StatusBadRequest},
+		{name: "valid body", method: http.MethodPost, url: "/api/v1/starwar/characters", contentType: "application/json", body: `{"name": "Darth Vader", "films": ["https://swapi.dev/api/films/1/", 2, {"id": 3}]}`, statusCode: http.StatusOK},
+		{name: "invalid body", method: http.MethodPost, url: "/api/v1/starwar/characters", contentType: "application/json", body: `{"name": 4}`, statusCode: http.StatusBadRequest},
+		{name: "body of another media type", method: http.MethodPost, url: "/api/v1/starwar/characters", contentType: "application/xml", body: `<response><name>Darth Vader</name></response>`, statusCode: http.StatusOK},
+		{name: "valid search", method: http.MethodGet, url: "/api/v1/starwar/characters/search?q=skywlker&limit=5", statusCode: http.StatusOK},
+		{name: "invalid search limit", method: http.MethodGet, url: "/api/v1/starwar/characters/search?q=skywlker&limit=five", statusCode: http.StatusBadRequest},
+		{name: "valid stats", method: http.MethodGet, url: "/api/v1/starwar/characters/stats?min_height=100&filter=gender%20eq%20%22male%22", statusCode: http.StatusOK},
+		{name: "invalid stats height", method: http.MethodGet, url: "/api/v1/starwar/characters/stats?min_height=tall", statusCode: http.StatusBadRequest},
+		{name: "route outside the specification", method: http.MethodGet, url: "/api/v1/starwar/planets/1?limit=ten", statusCode: http.StatusOK},
+	}
+
+	validator := newValidator(t, &openapiModel.ValidatorOptions{Requests: true})
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			var body io.Reader
+			if test.body != "" {
+				body = strings.NewReader(test.body)
+			}
+
+			newRequest := httptest.NewRequest(test.method, test.url, body)
+			newRequest.Header.Set("Content-Type", test.contentType)
+
+			response := httptest.NewRecorder()
+
+			validator.Validated(func(w http.ResponseWriter, r *http.Request) {
+				// the handler still reads the body the validator went through.
+				read, _ := io.ReadAll(r.Body)
+				assert.EqualValues(t, test.body, string(read))
+				w.WriteHeader(http.StatusOK)
+			})(response, newRequest)
+
+			assert.EqualValues(t, test.statusCode, response.Result().StatusCode)
+		})
+	}
+}
+
+func TestValidator_ValidatedResponses(t *testing.T) {
+
+	type testCase struct {
+		name       string
+		url        string
+		handler    http.HandlerFunc
+		statusCode int
+		body       string
+	}
+
+	testCases := []testCase{
+		{name: "valid character", url: "/api/v1/starwar/characters/1", handler: answer(http.StatusOK, "application/json", `{"Id": 1, "name": "Darth Vader", "films": [{"id": 1, "url": ""}]}`), statusCode: http.StatusOK, body: `{"Id": 1, "name": "Darth Vader", "films": [{"id": 1, "url": ""}]}`},
+		{name: "drifted character", url: "/api/v1/starwar/characters/1", handler: answer(http.StatusOK, "application/json", `{"Id": "1"}`), statusCode: http.StatusInternalServerError},
+		{name: "incomplete listing", url: "/api/v1/starwar/characters", handler: answer(http.StatusOK, "application/json", `{"count": 1}`), statusCode: http.StatusInternalServerError},
+		{name: "plain text error", url: "/api/v1/starwar/characters/1", handler: answer(http.StatusNotFound, "", "Character not found"), statusCode: http.StatusNotFound, body: "Character not found"},
+		{name: "not modified", url: "/api/v1/starwar/characters/1", handler: answer(http.StatusNotModified, "", ""), statusCode: http.StatusNotModified},
+		{name: "another media type", url: "/api/v1/starwar/characters/1", handler: answer(http.StatusOK, "application/xml", "<response></response>"), statusCode: http.StatusOK, body: "<response></response>"},
+	}
+
+	validator := newValidator(t, &openapiModel.ValidatorOptions{Responses: true})
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			newRequest := httptest.NewRequest(http.MethodGet, test.url, nil)
+
+			response := httptest.NewRecorder()
+
+			validator.Validated(test.handler)(response, newRequest)
+
+			assert.EqualValues(t, test.statusCode, response.Result().StatusCode)
+			if test.body != "" {
+				buf := new(bytes.Buffer)
+				buf.ReadFrom(response.Result().Body)
+				assert.EqualValues(t, test.body, buf.String())
+			}
+		})
+	}
+}
+
+func TestValidator_ValidatedDisabled(t *testing.T) {
+	validator := newValidator(t, &openapiModel.ValidatorOptions{})
+
+	newRequest := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters?limit=ten", nil)
+
+	response := httptest.NewRecorder()
+
+	validator.Validated(answer(http.StatusOK, "application/json", `{"count": 1}`))(response, newRequest)
+
+	assert.EqualValues(t, http.StatusOK, response.Result().StatusCode)
+}
@@ -0,0 +1,365 @@
+package respository
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var _ out.CharacterLinkRepository = (*StarwarRepositoryAdapter)(nil)
+
+// characterLinkTable describes the resource table and the join table behind a character relation.
+type characterLinkTable struct {
+	name     string
+	resource string
+	join     string
+	column   string
+}
+
+// characterLinkTables maps every link kind to its tables, nothing else reaches the link queries.
+var characterLinkTables = map[model.LinkKind]characterLinkTable{
+	model.LinkFilms:     {name: "Film", resource: "starwar.film", join: "starwar.character_film", column: "film_id"},
+	model.LinkSpecies:   {name: "Species", resource: "starwar.species", join: "starwar.character_species", column: "species_id"},
+	model.LinkVehicles:  {name: "Vehicle", resource: "starwar.vehicle", join: "starwar.character_vehicle", column: "vehicle_id"},
+	model.LinkStarships: {name: "Starship", resource: "starwar.starship", join: "starwar.character_starship", column: "starship_id"},
+}
+
+var selectCharacterExists = `SELECT EXISTS (SELECT 1 FROM starwar.character WHERE id = $1);`
+
+// selectCharacterLinks reads every relation of a set of characters in a single round trip.
+var selectCharacterLinks = buildSelectCharacterLinks()
+
+func buildSelectCharacterLinks() string {
+	selects := make([]string, 0, len(model.LinkKinds))
+	for _, kind := range model.LinkKinds {
+		table := characterLinkTables[kind]
+		selects = append(selects, fmt.Sprintf(
+			"SELECT l.character_id, '%s'::text, r.id, COALESCE(r.url, '') FROM %s l JOIN %s r ON r.id = l.%s WHERE l.character_id = ANY($1)",
+			kind, table.join, table.resource, table.column))
+	}
+
+	return strings.Join(selects, "\nUNION ALL\n") + "\nORDER BY 1, 3;"
+}
+
+func characterExists(q querier, character *model.CharacterIdentifier, ctx context.Context) error {
+	var exists bool
+	err := q.QueryRow(ctx, selectCharacterExists, character.Id).Scan(&exists)
+
+	if err != nil {
+		log.Printf("Error checking character %s\n", err.Error())
+		return pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error checking character %s\n", err.Error()),
+		}
+	}
+
+	if !exists {
+		return pkg.GenericException{
+			StatusCode: http.StatusNotFound,
+			Msj:        fmt.Sprintf("Character Not Found: %d\n", character.Id),
+		}
+	}
+
+	return nil
+}
+
+// findCharacterLinks fills the links of the given characters.
+func findCharacterLinks(q querier, characters []*model.CharacterDetail, ctx context.Context) error {
+	if len(characters) == 0 {
+		return nil
+	}
+
+	byId := make(map[int]*model.Character, len(characters))
+	ids := make([]int, 0, len(characters))
+	for _, characterDetail := range characters {
+		byId[characterDetail.Id.Id] = characterDetail.Character
+		ids = append(ids, characterDetail.Id.Id)
+	}
+
+	rows, err := q.Query(ctx, selectCharacterLinks, ids)
+	if err != nil {
+		log.Printf("Error finding character links: %s\n", err.Error())
+		return pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error finding character links: %s\n", err.Error()),
+		}
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var characterId int
+		var kind string
+		link := model.ResourceLink{}
+
+		if scanErr := rows.Scan(&characterId, &kind, &link.Id, &link.Url); scanErr != nil {
+			log.Printf("Error reading character links: %s\n", scanErr.Error())
+			return pkg.GenericException{
+				StatusCode: http.StatusInternalServerError,
+				Msj:        fmt.Sprintf("Error reading character links: %s\n", scanErr.Error()),
+			}
+		}
+
+		character := byId[characterId]
+		character.SetLinks(model.LinkKind(kind), append(character.Links(model.LinkKind(kind)), link))
+	}
+
+	if rows.Err() != nil {
+		log.Printf("Error reading character links: %s\n", rows.Err().Error())
+		return pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error reading character links: %s\n", rows.Err().Error()),
+		}
+	}
+
+	return nil
+}
+
+// saveCharacterLinks replaces every relation of the character and returns the links resolved to ids and urls.
+// It must run inside the ambient transaction, so a failed link rolls the character back.
+// With skipUnknown links to missing resources are dropped instead of failing.
+func saveCharacterLinks(q querier, characterId int, character *model.Character, skipUnknown bool, ctx context.Context) (map[model.LinkKind][]model.ResourceLink, error) {
+	saved := make(map[model.LinkKind][]model.ResourceLink, len(model.LinkKinds))
+
+	for _, kind := range model.LinkKinds {
+		table := characterLinkTables[kind]
+
+		resolved, err := resolveCharacterLinks(q, kind, character.Links(kind), skipUnknown, ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := q.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE character_id = $1;", table.join), characterId); err != nil {
+			log.Printf("Error removing character %s: %s\n", kind, err.Error())
+			return nil, pkg.GenericException{
+				StatusCode: http.StatusInternalServerError,
+				Msj:        fmt.Sprintf("Error saving character %s: %s\n", kind, err.Error()),
+			}
+		}
+
+		if len(resolved) > 0 {
+			ids := make([]int, 0, len(resolved))
+			for _, link := range resolved {
+				ids = append(ids, link.Id)
+			}
+
+			insertLinks := fmt.Sprintf(
+				"INSERT INTO %s (character_id, %s) SELECT $1, unnest($2::integer[]) ON CONFLICT DO NOTHING;",
+				table.join, table.column)
+
+			if _, err := q.Exec(ctx, insertLinks, characterId, ids); err != nil {
+				log.Printf("Error saving character %s: %s\n", kind, err.Error())
+				return nil, pkg.GenericException{
+					StatusCode: http.StatusInternalServerError,
+					Msj:        fmt.Sprintf("Error saving character %s: %s\n", kind, err.Error()),
+				}
+			}
+		}
+
+		saved[kind] = resolved
+	}
+
+	return saved, nil
+}
+
+// resolveCharacterLinks looks up the linked resources by id or url, a link to a missing resource is a 422
+// unless skipUnknown is set.
+func resolveCharacterLinks(q querier, kind model.LinkKind, links []model.ResourceLink, skipUnknown bool, ctx context.Context) ([]model.ResourceLink, error) {
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	table := characterLinkTables[kind]
+
+	ids := []int{}
+	urls := []string{}
+	for _, link := range links {
+		if link.Id != 0 {
+			ids = append(ids, link.Id)
+		} else {
+			urls = append(urls, link.Url)
+		}
+	}
+
+	rows, err := q.Query(ctx,
+		fmt.Sprintf("SELECT id, COALESCE(url, '') FROM %s WHERE id = ANY($1) OR url = ANY($2);", table.resource),
+		ids, urls)
+	if err != nil {
+		log.Printf("Error resolving character %s: %s\n", kind, err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error resolving character %s: %s\n", kind, err.Error()),
+		}
+	}
+
+	found, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.ResourceLink, error) {
+		link := model.ResourceLink{}
+		scanErr := row.Scan(&link.Id, &link.Url)
+		return link, scanErr
+	})
+	if err != nil {
+		log.Printf("Error resolving character %s: %s\n", kind, err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error resolving character %s: %s\n", kind, err.Error()),
+		}
+	}
+
+	byId := make(map[int]model.ResourceLink, len(found))
+	byUrl := make(map[string]model.ResourceLink, len(found))
+	for _, link := range found {
+		byId[link.Id] = link
+		if link.Url != "" {
+			byUrl[link.Url] = link
+		}
+	}
+
+	resolved := make([]model.ResourceLink, 0, len(links))
+	unknown := []string{}
+	seen := map[int]bool{}
+	for _, link := range links {
+		match, ok := byUrl[link.Url]
+		if link.Id != 0 {
+			match, ok = byId[link.Id]
+		}
+
+		if !ok {
+			reference := link.Url
+			if link.Id != 0 {
+				reference = strconv.Itoa(link.Id)
+			}
+			unknown = append(unknown, reference)
+			continue
+		}
+
+		if !seen[match.Id] {
+			seen[match.Id] = true
+			resolved = append(resolved, match)
+		}
+	}
+
+	if len(unknown) > 0 && skipUnknown {
+		log.Printf("Skipping unknown %s: %s\n", kind, strings.Join(unknown, ", "))
+	}
+
+	if len(unknown) > 0 && !skipUnknown {
+		msj := fmt.Sprintf("Unknown %s: %s\n", kind, strings.Join(unknown, ", "))
+		log.Print(msj)
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusUnprocessableEntity,
+			Msj:        msj,
+		}
+	}
+
+	return resolved, nil
+}
+
+// buildLinkedCharactersCountQuery counts every character related to the resource, not only the ones of the page.
+func buildLinkedCharactersCountQuery(table characterLinkTable) string {
+	return fmt.Sprintf("SELECT count(*) FROM %s WHERE %s = $1;", table.join, table.column)
+}
+
+func buildLinkedCharactersQuery(table characterLinkTable) string {
+	columns, _ := projectCharacterColumns(nil, &repositoryModel.CharacterRepository{})
+
+	return "SELECT " + columns + fromCharacters + fmt.Sprintf(`
+							JOIN %s l ON l.character_id = c.id
+							WHERE l.%s = $1
+							ORDER BY c.id
+							LIMIT $2 OFFSET $3;`, table.join, table.column)
+}
+
+func (a *StarwarRepositoryAdapter) FindLinkedCharacters(link *model.CharacterLink, ctx context.Context) (*model.CharacterPage, error) {
+
+	ctx = withOperation(ctx, "find_linked_characters")
+
+	log.Printf("FindLinkedCharacters: %s %d\n", link.Kind, link.Id)
+
+	table, ok := characterLinkTables[link.Kind]
+	if !ok {
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusBadRequest,
+			Msj:        fmt.Sprintf("Invalid character relation: %s\n", link.Kind),
+		}
+	}
+
+	var exists bool
+	err := a.querier(ctx).QueryRow(ctx,
+		fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE id = $1);", table.resource),
+		link.Id).Scan(&exists)
+
+	if err != nil {
+		log.Printf("Error checking %s %s\n", link.Kind, err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error checking %s %s\n", link.Kind, err.Error()),
+		}
+	}
+
+	if !exists {
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusNotFound,
+			Msj:        fmt.Sprintf("%s Not Found: %d\n", table.name, link.Id),
+		}
+	}
+
+	page := &model.CharacterPage{Limit: link.Limit, Offset: link.Offset}
+
+	err = a.querier(ctx).QueryRow(ctx, buildLinkedCharactersCountQuery(table), link.Id).Scan(&page.Total)
+	if err != nil {
+		log.Printf("Error counting linked characters: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error counting linked characters: %s\n", err.Error()),
+		}
+	}
+
+	rows, err := a.querier(ctx).Query(ctx, buildLinkedCharactersQuery(table), link.Id, link.Limit, link.Offset)
+	if err != nil {
+		log.Printf("Error finding linked characters: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error finding linked characters: %s\n", err.Error()),
+		}
+	}
+	defer rows.Close()
+
+	characters := []*model.CharacterDetail{}
+
+	for rows.Next() {
+		findResponse, scanErr := scanProjectedCharacter(rows, nil)
+
+		if scanErr != nil {
+			log.Printf("Error reading linked characters: %s\n", scanErr.Error())
+			return nil, pkg.GenericException{
+				StatusCode: http.StatusInternalServerError,
+				Msj:        fmt.Sprintf("Error reading linked characters: %s\n", scanErr.Error()),
+			}
+		}
+
+		characters = append(characters, characterToDomain(findResponse))
+	}
+
+	if rows.Err() != nil {
+		log.Printf("Error reading linked characters: %s\n", rows.Err().Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error reading linked characters: %s\n", rows.Err().Error()),
+		}
+	}
+
+	if err := findCharacterLinks(a.querier(ctx), characters, ctx); err != nil {
+		return nil, err
+	}
+
+	page.Characters = characters
+
+	return page, nil
+}
@@ -0,0 +1,40 @@
+package respository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"handler/function/internal/application/model"
+	"strings"
+	"testing"
+)
+
+func TestBuildSelectCharacterLinks(t *testing.T) {
+	sql := buildSelectCharacterLinks()
+
+	for _, kind := range model.LinkKinds {
+		table := characterLinkTables[kind]
+		assert.Contains(t, sql, "'"+string(kind)+"'::text")
+		assert.Contains(t, sql, table.join+" l JOIN "+table.resource+" r ON r.id = l."+table.column)
+	}
+
+	assert.Equal(t, len(model.LinkKinds)-1, strings.Count(sql, "UNION ALL"))
+	assert.True(t, strings.HasSuffix(sql, "ORDER BY 1, 3;"))
+}
+
+func TestCharacterLinkTablesCoverEveryKind(t *testing.T) {
+	for _, kind := range model.LinkKinds {
+		_, ok := characterLinkTables[kind]
+		assert.True(t, ok, string(kind))
+	}
+}
+
+func TestBuildLinkedCharactersQuery(t *testing.T) {
+	table := characterLinkTables[model.LinkFilms]
+
+	sql := buildLinkedCharactersQuery(table)
+
+	assert.Contains(t, sql, "JOIN "+table.join+" l ON l.character_id = c.id")
+	assert.Contains(t, sql, "WHERE l."+table.column+" = $1")
+	assert.True(t, strings.HasSuffix(sql, "LIMIT $2 OFFSET $3;"))
+	assert.NotContains(t, sql, "OVER()")
+	assert.Equal(t, "SELECT count(*) FROM "+table.join+" WHERE "+table.column+" = $1;", buildLinkedCharactersCountQuery(table))
+}
@@ -0,0 +1,106 @@
+package respository
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"handler/function/internal/application/model"
+	"log"
+	"strings"
+)
+
+var fromCharacters = `
+							FROM starwar.character c
+							LEFT JOIN starwar.planet p ON p.id = c.homeworld_id`
+
+// characterColumn selects the columns backing a field of model.CharacterFields.
+type characterColumn struct {
+	field string
+	sql   string
+	dest  func(c *repositoryModel.CharacterRepository) []any
+}
+
+// characterColumns follow the order of searchCharacters, so without a projection both select the same.
+var characterColumns = []characterColumn{
+	{field: "name", sql: "c.name", dest: func(c *repositoryModel.CharacterRepository) []any { return []any{&c.Name} }},
+	{field: "height", sql: "c.height", dest: func(c *repositoryModel.CharacterRepository) []any { return []any{&c.Height} }},
+	{field: "mass", sql: "c.mass", dest: func(c *repositoryModel.CharacterRepository) []any { return []any{&c.Mass} }},
+	{field: "hair_color", sql: "c.hair_color", dest: func(c *repositoryModel.CharacterRepository) []any { return []any{&c.HairColor} }},
+	{field: "skin_color", sql: "c.skin_color", dest: func(c *repositoryModel.CharacterRepository) []any { return []any{&c.SkinColor} }},
+	{field: "eye_color", sql: "c.eye_color", dest: func(c *repositoryModel.CharacterRepository) []any { return []any{&c.EyeColor} }},
+	{field: "birth_year", sql: "c.birth_year, c.birth_year_value", dest: func(c *repositoryModel.CharacterRepository) []any {
+		return []any{&c.BirthYear, &c.BirthYearValue}
+	}},
+	{field: "gender", sql: "c.gender", dest: func(c *repositoryModel.CharacterRepository) []any { return []any{&c.Gender} }},
+	{field: "homeworld", sql: "COALESCE(p.url, c.homewor_ld)", dest: func(c *repositoryModel.CharacterRepository) []any { return []any{&c.Homeworld} }},
+	{field: "homeworld_id", sql: "c.homeworld_id", dest: func(c *repositoryModel.CharacterRepository) []any { return []any{&c.HomeworldId} }},
+	{field: "created", sql: "c.created", dest: func(c *repositoryModel.CharacterRepository) []any { return []any{&c.Created} }},
+	{field: "edited", sql: "c.edited", dest: func(c *repositoryModel.CharacterRepository) []any { return []any{&c.Edited} }},
+	{field: "url", sql: "c.url", dest: func(c *repositoryModel.CharacterRepository) []any { return []any{&c.Url} }},
+}
+
+// selectsColumn keeps homeworld_id along with the homeworld, the planet is expanded through it.
+func selectsColumn(projection *model.CharacterProjection, column characterColumn) bool {
+	return projection.Includes(column.field) ||
+		(column.field == "homeworld_id" && projection.Includes("homeworld"))
+}
+
+// projectCharacterColumns returns the columns of the projection and where to scan them,
+// the id and the version are always selected as the ETag needs the version.
+func projectCharacterColumns(projection *model.CharacterProjection, c *repositoryModel.CharacterRepository) (string, []any) {
+	columns := []string{"c.id"}
+	dest := []any{&c.Id}
+
+	for _, column := range characterColumns {
+		if selectsColumn(projection, column) {
+			columns = append(columns, column.sql)
+			dest = append(dest, column.dest(c)...)
+		}
+	}
+
+	columns = append(columns, "c.version")
+	dest = append(dest, &c.Version)
+
+	return strings.Join(columns, ", "), dest
+}
+
+func scanProjectedCharacter(row pgx.Row, projection *model.CharacterProjection, extra ...any) (*repositoryModel.CharacterRepository, error) {
+	findResponse := repositoryModel.CharacterRepository{}
+	_, dest := projectCharacterColumns(projection, &findResponse)
+
+	err := row.Scan(append(dest, extra...)...)
+
+	return &findResponse, err
+}
+
+func buildFindCharacterProjection(projection *model.CharacterProjection) string {
+	columns, _ := projectCharacterColumns(projection, &repositoryModel.CharacterRepository{})
+
+	return "SELECT " + columns + fromCharacters + " WHERE c.id = $1;"
+}
+
+// FindCharacterProjection reads only the columns of the requested fields, the links
+// are only looked up when one of them is requested.
+func (a *StarwarRepositoryAdapter) FindCharacterProjection(character *model.CharacterIdentifier, projection *model.CharacterProjection, ctx context.Context) (*model.CharacterDetail, error) {
+
+	ctx = withOperation(ctx, "find_character_projection")
+
+	log.Printf("FindCharacterProjection: %d %+v\n", character.Id, projection)
+
+	findResponse, err := scanProjectedCharacter(
+		a.querier(ctx).QueryRow(ctx, buildFindCharacterProjection(projection), character.Id), projection)
+
+	if err != nil {
+		return nil, findCharacterError(character, err)
+	}
+
+	characterDetail := characterToDomain(findResponse)
+
+	if projection.IncludesLinks() {
+		if err := findCharacterLinks(a.querier(ctx), []*model.CharacterDetail{characterDetail}, ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return characterDetail, nil
+}
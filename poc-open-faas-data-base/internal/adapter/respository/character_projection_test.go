@@ -0,0 +1,40 @@
+package respository
+
+import (
+	"github.com/stretchr/testify/assert"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"handler/function/internal/application/model"
+	"strings"
+	"testing"
+)
+
+func TestProjectCharacterColumns(t *testing.T) {
+
+	projection, err := model.ParseCharacterProjection("name,height,homeworld")
+	assert.Nil(t, err)
+
+	character := &repositoryModel.CharacterRepository{}
+	columns, dest := projectCharacterColumns(projection, character)
+
+	assert.Equal(t, "c.id, c.name, c.height, COALESCE(p.url, c.homewor_ld), c.homeworld_id, c.version", columns)
+	assert.Equal(t, []any{&character.Id, &character.Name, &character.Height, &character.Homeworld, &character.HomeworldId, &character.Version}, dest)
+}
+
+func TestProjectCharacterColumnsWithoutProjection(t *testing.T) {
+
+	columns, dest := projectCharacterColumns(nil, &repositoryModel.CharacterRepository{})
+
+	search := strings.Join(strings.Fields(searchCharacters), " ")
+	assert.Contains(t, search, "SELECT "+columns+", (ts_rank(")
+	assert.Len(t, dest, 16)
+}
+
+func TestBuildFindCharacterProjection(t *testing.T) {
+
+	projection, err := model.ParseCharacterProjection("birth_year")
+	assert.Nil(t, err)
+
+	sql := strings.Join(strings.Fields(buildFindCharacterProjection(projection)), " ")
+
+	assert.Equal(t, "SELECT c.id, c.birth_year, c.birth_year_value, c.version FROM starwar.character c LEFT JOIN starwar.planet p ON p.id = c.homeworld_id WHERE c.id = $1;", sql)
+}
@@ -0,0 +1,176 @@
+package respository
+
+import (
+	"fmt"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"handler/function/internal/application/model"
+	"strings"
+)
+
+// characterSortColumns maps the sortable domain fields to columns, nothing else reaches ORDER BY.
+var characterSortColumns = map[string]string{
+	"id":         "c.id",
+	"name":       "c.name",
+	"height":     "c.height",
+	"mass":       "c.mass",
+	"birth_year": "c.birth_year_value",
+}
+
+// characterFilterColumns maps the filterable domain fields to columns, nothing else reaches WHERE.
+var characterFilterColumns = map[string]string{
+	"name":       "c.name",
+	"gender":     "c.gender",
+	"hair_color": "c.hair_color",
+	"skin_color": "c.skin_color",
+	"eye_color":  "c.eye_color",
+	"height":     "c.height",
+	"mass":       "c.mass",
+	"birth_year": "c.birth_year_value",
+}
+
+var filterComparisons = map[model.ComparisonOperator]string{
+	model.FilterEq: "=",
+	model.FilterNe: "IS DISTINCT FROM",
+	model.FilterGt: ">",
+	model.FilterGe: ">=",
+	model.FilterLt: "<",
+	model.FilterLe: "<=",
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+type characterQueryBuilder struct {
+	conditions []string
+	args       []any
+}
+
+func (b *characterQueryBuilder) addArg(arg any) string {
+	b.args = append(b.args, arg)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+func (b *characterQueryBuilder) addRange(column string, rangeFilter model.RangeFilter) {
+	if rangeFilter.Min != nil {
+		b.conditions = append(b.conditions, fmt.Sprintf("%s >= %s", column, b.addArg(*rangeFilter.Min)))
+	}
+	if rangeFilter.Max != nil {
+		b.conditions = append(b.conditions, fmt.Sprintf("%s <= %s", column, b.addArg(*rangeFilter.Max)))
+	}
+}
+
+// addFilter compiles the expression with every value as a parameter, only whitelisted
+// columns and operators are written into the sql.
+func (b *characterQueryBuilder) addFilter(expression model.FilterExpression) {
+	if expression != nil {
+		b.conditions = append(b.conditions, b.compileFilter(expression))
+	}
+}
+
+func (b *characterQueryBuilder) compileFilter(expression model.FilterExpression) string {
+	switch e := expression.(type) {
+	case model.FilterLogical:
+		operator := "AND"
+		if e.Operator == model.FilterOr {
+			operator = "OR"
+		}
+		return fmt.Sprintf("(%s %s %s)", b.compileFilter(e.Left), operator, b.compileFilter(e.Right))
+
+	case model.FilterNot:
+		return fmt.Sprintf("NOT %s", b.compileFilter(e.Expression))
+
+	case model.FilterComparison:
+		return b.compileComparison(e)
+	}
+
+	return "FALSE"
+}
+
+func (b *characterQueryBuilder) compileComparison(comparison model.FilterComparison) string {
+	column, ok := characterFilterColumns[comparison.Field]
+	if !ok {
+		return "FALSE"
+	}
+
+	if comparison.Value == nil {
+		if comparison.Operator == model.FilterNe {
+			return fmt.Sprintf("(%s IS NOT NULL)", column)
+		}
+		return fmt.Sprintf("(%s IS NULL)", column)
+	}
+
+	if comparison.Operator == model.FilterContains {
+		text, _ := comparison.Value.(string)
+		return fmt.Sprintf("(%s ILIKE %s)", column, b.addArg("%"+likeEscaper.Replace(text)+"%"))
+	}
+
+	operator, ok := filterComparisons[comparison.Operator]
+	if !ok {
+		return "FALSE"
+	}
+
+	return fmt.Sprintf("(%s %s %s)", column, operator, b.addArg(comparison.Value))
+}
+
+// newCharacterQueryBuilder adds the filters of the query, the listing and the statistics share them.
+func newCharacterQueryBuilder(query *model.CharacterQuery) *characterQueryBuilder {
+	builder := &characterQueryBuilder{}
+
+	if len(query.Ids) > 0 {
+		builder.conditions = append(builder.conditions, fmt.Sprintf("c.id = ANY(%s)", builder.addArg(query.Ids)))
+	}
+
+	builder.addRange("c.height", query.Height)
+	builder.addRange("c.mass", query.Mass)
+	builder.addRange("c.birth_year_value", query.BirthYear)
+	builder.addFilter(query.Filter)
+
+	return builder
+}
+
+func (b *characterQueryBuilder) where() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+
+	return " WHERE " + strings.Join(b.conditions, " AND ")
+}
+
+// buildCharacterCountQuery counts the characters matching the filters of the query, a page past the last one
+// has no rows to carry a window count.
+func buildCharacterCountQuery(query *model.CharacterQuery) (string, []any) {
+	builder := newCharacterQueryBuilder(query)
+
+	return "SELECT count(*)" + fromCharacters + builder.where() + ";", builder.args
+}
+
+func buildCharacterQuery(query *model.CharacterQuery) (string, []any) {
+	builder := newCharacterQueryBuilder(query)
+
+	columns, _ := projectCharacterColumns(query.Projection, &repositoryModel.CharacterRepository{})
+
+	sql := strings.Builder{}
+	sql.WriteString("SELECT " + columns + fromCharacters)
+	sql.WriteString(builder.where())
+
+	orderBy := make([]string, 0, len(query.Sort)+1)
+	for _, sort := range query.Sort {
+		column, ok := characterSortColumns[sort.Field]
+		if !ok {
+			continue
+		}
+
+		direction := "ASC"
+		if sort.Descending {
+			direction = "DESC"
+		}
+		orderBy = append(orderBy, fmt.Sprintf("%s %s NULLS LAST", column, direction))
+	}
+	orderBy = append(orderBy, "c.id ASC")
+
+	sql.WriteString(" ORDER BY ")
+	sql.WriteString(strings.Join(orderBy, ", "))
+
+	sql.WriteString(fmt.Sprintf(" LIMIT %s OFFSET %s;", builder.addArg(query.Limit), builder.addArg(query.Offset)))
+
+	return sql.String(), builder.args
+}
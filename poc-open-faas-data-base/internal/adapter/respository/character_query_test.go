@@ -0,0 +1,138 @@
+package respository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"handler/function/internal/application/model"
+	"strings"
+	"testing"
+)
+
+func TestBuildCharacterQuery(t *testing.T) {
+
+	minHeight := 150.0
+	maxMass := 80.5
+
+	query := &model.CharacterQuery{
+		Height: model.RangeFilter{Min: &minHeight},
+		Mass:   model.RangeFilter{Max: &maxMass},
+		Sort:   []model.SortOrder{{Field: "height", Descending: true}, {Field: "mass"}},
+		Limit:  10,
+		Offset: 20,
+	}
+
+	sql, args := buildCharacterQuery(query)
+
+	assert.True(t, strings.HasSuffix(sql, " WHERE c.height >= $1 AND c.mass <= $2 ORDER BY c.height DESC NULLS LAST, c.mass ASC NULLS LAST, c.id ASC LIMIT $3 OFFSET $4;"))
+	assert.Equal(t, []any{150.0, 80.5, 10, 20}, args)
+}
+
+func TestBuildCharacterQueryWithoutFilters(t *testing.T) {
+
+	query := &model.CharacterQuery{
+		Sort:  []model.SortOrder{{Field: "height; DROP TABLE starwar.character"}},
+		Limit: 10,
+	}
+
+	sql, args := buildCharacterQuery(query)
+
+	assert.NotContains(t, sql, "WHERE")
+	assert.NotContains(t, sql, "DROP")
+	assert.True(t, strings.HasSuffix(sql, " ORDER BY c.id ASC LIMIT $1 OFFSET $2;"))
+	assert.Equal(t, []any{10, 0}, args)
+}
+
+func TestBuildCharacterQueryIds(t *testing.T) {
+
+	minHeight := 150.0
+
+	query := &model.CharacterQuery{
+		Ids:    []int{4, 1},
+		Height: model.RangeFilter{Min: &minHeight},
+		Limit:  2,
+	}
+
+	sql, args := buildCharacterQuery(query)
+
+	assert.True(t, strings.HasSuffix(sql, " WHERE c.id = ANY($1) AND c.height >= $2 ORDER BY c.id ASC LIMIT $3 OFFSET $4;"))
+	assert.Equal(t, []any{[]int{4, 1}, 150.0, 2, 0}, args)
+}
+
+func TestBuildCharacterQueryBirthYear(t *testing.T) {
+
+	bornFrom := -50.0
+	bornTo := 0.0
+
+	query := &model.CharacterQuery{
+		BirthYear: model.RangeFilter{Min: &bornFrom, Max: &bornTo},
+		Sort:      []model.SortOrder{{Field: "birth_year"}},
+		Limit:     10,
+	}
+
+	sql, args := buildCharacterQuery(query)
+
+	assert.True(t, strings.HasSuffix(sql, " WHERE c.birth_year_value >= $1 AND c.birth_year_value <= $2 ORDER BY c.birth_year_value ASC NULLS LAST, c.id ASC LIMIT $3 OFFSET $4;"))
+	assert.Equal(t, []any{-50.0, 0.0, 10, 0}, args)
+}
+
+func TestBuildCharacterQueryFilter(t *testing.T) {
+
+	filter, err := model.ParseFilter(`gender eq "female" and (height gt 170 or mass eq null) and not name contains "50%_off"`)
+	assert.Nil(t, err)
+
+	query := &model.CharacterQuery{
+		Filter: filter,
+		Limit:  10,
+	}
+
+	sql, args := buildCharacterQuery(query)
+
+	assert.True(t, strings.HasSuffix(sql, " WHERE (((c.gender = $1) AND ((c.height > $2) OR (c.mass IS NULL))) AND NOT (c.name ILIKE $3)) ORDER BY c.id ASC LIMIT $4 OFFSET $5;"))
+	assert.Equal(t, []any{"female", 170.0, `%50\%\_off%`, 10, 0}, args)
+}
+
+func TestBuildCharacterQueryFilterValuesAreParameters(t *testing.T) {
+
+	filter, err := model.ParseFilter(`name ne "x' OR 1=1; --" and birth_year lt "19BBY"`)
+	assert.Nil(t, err)
+
+	query := &model.CharacterQuery{
+		Height: model.RangeFilter{},
+		Filter: filter,
+		Limit:  10,
+	}
+
+	sql, args := buildCharacterQuery(query)
+
+	assert.NotContains(t, sql, "OR 1=1")
+	assert.True(t, strings.HasSuffix(sql, " WHERE ((c.name IS DISTINCT FROM $1) AND (c.birth_year_value < $2)) ORDER BY c.id ASC LIMIT $3 OFFSET $4;"))
+	assert.Equal(t, []any{"x' OR 1=1; --", -19.0, 10, 0}, args)
+}
+
+func TestBuildCharacterQueryProjection(t *testing.T) {
+
+	projection, err := model.ParseCharacterProjection("name")
+	assert.Nil(t, err)
+
+	sql, _ := buildCharacterQuery(&model.CharacterQuery{Projection: projection, Limit: 10})
+
+	assert.True(t, strings.HasPrefix(strings.Join(strings.Fields(sql), " "), "SELECT c.id, c.name, c.version FROM"))
+}
+
+func TestBuildCharacterCountQuery(t *testing.T) {
+
+	minHeight := 150.0
+
+	query := &model.CharacterQuery{
+		Height: model.RangeFilter{Min: &minHeight},
+		Sort:   []model.SortOrder{{Field: "height"}},
+		Limit:  10,
+		Offset: 90,
+	}
+
+	sql, args := buildCharacterCountQuery(query)
+
+	assert.True(t, strings.HasPrefix(sql, "SELECT count(*)"))
+	assert.True(t, strings.HasSuffix(sql, " WHERE c.height >= $1;"))
+	assert.NotContains(t, sql, "LIMIT")
+	assert.Equal(t, []any{150.0}, args)
+}
@@ -0,0 +1,242 @@
+package respository
+
+import (
+	"context"
+	"fmt"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"html"
+	"log"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+var _ out.CharacterSearchRepository = (*StarwarRepositoryAdapter)(nil)
+
+// $1 is the raw search used for the trigram similarity, $2 the prefix tsquery built by buildSearchTsQuery.
+var searchCharacters = `WITH search AS (SELECT to_tsquery('simple', $2) AS query)
+						SELECT c.id,
+                               c.name,
+                               c.height,
+                               c.mass,
+                               c.hair_color,
+                               c.skin_color,
+                               c.eye_color,
+                               c.birth_year,
+                               c.birth_year_value,
+                               c.gender,
+                               COALESCE(p.url, c.homewor_ld),
+                               c.homeworld_id,
+                               c.created,
+                               c.edited,
+                               c.url,
+                               c.version,
+                               (ts_rank(c.search_vector, search.query) + word_similarity($1, c.name))::float8 AS score,
+                               ts_headline('simple', c.name, search.query, $5),
+                               ts_headline('simple', COALESCE(c.gender, ''), search.query, $5),
+                               ts_headline('simple', COALESCE(c.hair_color, ''), search.query, $5),
+                               ts_headline('simple', COALESCE(c.skin_color, ''), search.query, $5),
+                               ts_headline('simple', COALESCE(c.eye_color, ''), search.query, $5)
+							FROM starwar.character c
+							CROSS JOIN search
+							LEFT JOIN starwar.planet p ON p.id = c.homeworld_id
+							WHERE c.search_vector @@ search.query OR $1 <% c.name
+							ORDER BY score DESC, c.id ASC
+							LIMIT $3 OFFSET $4;`
+
+// countSearchCharacters counts every match of searchCharacters, a page past the last one has no rows to count.
+var countSearchCharacters = `SELECT count(*)
+							FROM starwar.character c
+							WHERE c.search_vector @@ to_tsquery('simple', $2) OR $1 <% c.name;`
+
+// ts_headline marks the words with private use characters, the headline is HTML escaped
+// before they become <em>, so the stored text never reaches the client as markup.
+const (
+	highlightStart   = "<em>"
+	highlightStop    = "</em>"
+	headlineStart    = "\uE000"
+	headlineStop     = "\uE001"
+	headlineOptions  = "StartSel=" + headlineStart + ", StopSel=" + headlineStop + ", HighlightAll=true"
+	fuzzyHighlightAt = 0.3
+)
+
+var headlineMarks = strings.NewReplacer(headlineStart, highlightStart, headlineStop, highlightStop)
+
+// searchHighlightFields are the highlighted fields in the order of the ts_headline columns.
+var searchHighlightFields = []string{"name", "gender", "hair_color", "skin_color", "eye_color"}
+
+func (a *StarwarRepositoryAdapter) SearchCharacters(search *model.CharacterSearch, ctx context.Context) (*model.CharacterSearchResult, error) {
+
+	ctx = withOperation(ctx, "search_characters")
+
+	log.Printf("SearchCharacters: %+v\n", search)
+
+	tsQuery := buildSearchTsQuery(search.Text)
+
+	result := &model.CharacterSearchResult{
+		Matches: []*model.CharacterMatch{},
+		Limit:   search.Limit,
+		Offset:  search.Offset,
+	}
+
+	err := a.querier(ctx).QueryRow(ctx, countSearchCharacters, search.Text, tsQuery).Scan(&result.Total)
+	if err != nil {
+		log.Printf("Error counting characters: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error counting characters: %s\n", err.Error()),
+		}
+	}
+
+	rows, err := a.querier(ctx).Query(ctx, searchCharacters,
+		search.Text, tsQuery, search.Limit, search.Offset, headlineOptions)
+	if err != nil {
+		log.Printf("Error searching characters: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error searching characters: %s\n", err.Error()),
+		}
+	}
+	defer rows.Close()
+
+	characters := []*model.CharacterDetail{}
+
+	for rows.Next() {
+		var score float64
+		headlines := make([]string, len(searchHighlightFields))
+
+		findResponse, scanErr := scanProjectedCharacter(rows, nil, &score,
+			&headlines[0], &headlines[1], &headlines[2], &headlines[3], &headlines[4])
+
+		if scanErr != nil {
+			log.Printf("Error reading characters: %s\n", scanErr.Error())
+			return nil, pkg.GenericException{
+				StatusCode: http.StatusInternalServerError,
+				Msj:        fmt.Sprintf("Error reading characters: %s\n", scanErr.Error()),
+			}
+		}
+
+		character := characterToDomain(findResponse)
+		characters = append(characters, character)
+		result.Matches = append(result.Matches, &model.CharacterMatch{
+			Character:  character,
+			Score:      score,
+			Highlights: searchHighlights(findResponse, headlines, search.Text),
+		})
+	}
+
+	if rows.Err() != nil {
+		log.Printf("Error reading characters: %s\n", rows.Err().Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error reading characters: %s\n", rows.Err().Error()),
+		}
+	}
+
+	if err := findCharacterLinks(a.querier(ctx), characters, ctx); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Found %d characters of %d for %s\n", len(result.Matches), result.Total, search.Text)
+
+	return result, nil
+}
+
+// searchHighlights keeps the headlines with a highlighted word. A name matched only by
+// similarity has no lexeme for ts_headline to mark, so its closest words are marked here.
+func searchHighlights(c *repositoryModel.CharacterRepository, headlines []string, search string) map[string]string {
+	highlights := map[string]string{}
+
+	for i, field := range searchHighlightFields {
+		if strings.Contains(headlines[i], headlineStart) {
+			highlights[field] = headlineMarks.Replace(html.EscapeString(headlines[i]))
+		}
+	}
+
+	if _, ok := highlights["name"]; !ok {
+		if name := highlightClosestWords(c.Name, search); strings.Contains(name, highlightStart) {
+			highlights["name"] = name
+		}
+	}
+
+	return highlights
+}
+
+// buildSearchTsQuery turns "luke sky" into "luke:* & sky:*" so partial words match.
+// Only letters and digits are kept, nothing else reaches the tsquery syntax.
+func buildSearchTsQuery(search string) string {
+	terms := []string{}
+	for _, word := range searchWords(search) {
+		terms = append(terms, word+":*")
+	}
+
+	return strings.Join(terms, " & ")
+}
+
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// highlightClosestWords marks, for every word of the search, the word of the text with the
+// highest trigram similarity, as long as it reaches fuzzyHighlightAt. The words are HTML escaped.
+func highlightClosestWords(text string, search string) string {
+	words := strings.Fields(text)
+	marked := make([]bool, len(words))
+
+	for _, searchWord := range searchWords(search) {
+		best, bestSimilarity := -1, 0.0
+		for i, word := range words {
+			similarity := trigramSimilarity(searchWord, word)
+			if similarity > bestSimilarity {
+				best, bestSimilarity = i, similarity
+			}
+		}
+
+		if best >= 0 && bestSimilarity >= fuzzyHighlightAt {
+			marked[best] = true
+		}
+	}
+
+	for i, word := range words {
+		words[i] = html.EscapeString(word)
+		if marked[i] {
+			words[i] = highlightStart + words[i] + highlightStop
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// trigramSimilarity follows pg_trgm: shared trigrams over all the trigrams of both words.
+func trigramSimilarity(a string, b string) float64 {
+	trigramsA, trigramsB := trigrams(a), trigrams(b)
+	if len(trigramsA) == 0 || len(trigramsB) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for trigram := range trigramsA {
+		if trigramsB[trigram] {
+			shared++
+		}
+	}
+
+	return float64(shared) / float64(len(trigramsA)+len(trigramsB)-shared)
+}
+
+func trigrams(text string) map[string]bool {
+	result := map[string]bool{}
+	for _, word := range searchWords(text) {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			result[string(padded[i:i+3])] = true
+		}
+	}
+
+	return result
+}
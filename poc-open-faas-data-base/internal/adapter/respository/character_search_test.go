@@ -0,0 +1,69 @@
+package respository
+
+import (
+	"github.com/stretchr/testify/assert"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"testing"
+)
+
+func TestBuildSearchTsQuery(t *testing.T) {
+
+	type testCase struct {
+		testName string
+		search   string
+		expected string
+	}
+
+	testCases := []testCase{
+		{testName: "single word", search: "Sky", expected: "sky:*"},
+		{testName: "several words", search: "luke  sky", expected: "luke:* & sky:*"},
+		{testName: "tsquery syntax removed", search: "r2-d2 | !(vader):*", expected: "r2:* & d2:* & vader:*"},
+		{testName: "nothing searchable", search: "&|!", expected: ""},
+	}
+
+	for _, param := range testCases {
+		t.Run(param.testName, func(t *testing.T) {
+			assert.Equal(t, param.expected, buildSearchTsQuery(param.search))
+		})
+	}
+}
+
+func TestTrigramSimilarity(t *testing.T) {
+
+	assert.Equal(t, 1.0, trigramSimilarity("Skywalker", "skywalker"))
+	assert.InDelta(t, 7.0/12.0, trigramSimilarity("skywlker", "Skywalker"), 0.0001)
+	assert.Equal(t, 0.0, trigramSimilarity("", "Skywalker"))
+}
+
+func TestHighlightClosestWords(t *testing.T) {
+
+	assert.Equal(t, "Luke <em>Skywalker</em>", highlightClosestWords("Luke Skywalker", "skywlker"))
+	assert.Equal(t, "<em>Luke</em> <em>Skywalker</em>", highlightClosestWords("Luke Skywalker", "luk skywlker"))
+	assert.Equal(t, "Luke Skywalker", highlightClosestWords("Luke Skywalker", "vader"))
+	assert.Equal(t, "&lt;b&gt;Luke&lt;/b&gt; <em>Skywalker</em>", highlightClosestWords("<b>Luke</b> Skywalker", "skywlker"))
+}
+
+func TestSearchHighlights(t *testing.T) {
+
+	character := &repositoryModel.CharacterRepository{Name: "Luke Skywalker"}
+
+	highlights := searchHighlights(character,
+		[]string{"Luke Skywalker", "male", "\uE000blond\uE001", "fair", "blue"}, "skywlker blond")
+
+	assert.Equal(t, map[string]string{
+		"name":       "Luke <em>Skywalker</em>",
+		"hair_color": "<em>blond</em>",
+	}, highlights)
+}
+
+func TestSearchHighlightsEscapeHtml(t *testing.T) {
+
+	character := &repositoryModel.CharacterRepository{Name: "<script>Luke</script>"}
+
+	highlights := searchHighlights(character,
+		[]string{"<script>\uE000Luke\uE001</script>", "male", "<i>blond</i>", "fair", "blue"}, "luke")
+
+	assert.Equal(t, map[string]string{
+		"name": "&lt;script&gt;<em>Luke</em>&lt;/script&gt;",
+	}, highlights)
+}
@@ -0,0 +1,122 @@
+package respository
+
+import (
+	"context"
+	"fmt"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+)
+
+var _ out.CharacterStatsRepository = (*StarwarRepositoryAdapter)(nil)
+
+var selectCharacterMeasures = `SELECT count(*),
+                               min(c.height)::float8,
+                               max(c.height)::float8,
+                               avg(c.height)::float8,
+                               count(c.height),
+                               min(c.mass)::float8,
+                               max(c.mass)::float8,
+                               avg(c.mass)::float8,
+                               count(c.mass)` + fromCharacters
+
+// the groups share one scan of the filtered characters, the first column tells the grouping apart.
+var selectCharacterGroups = `SELECT CASE WHEN GROUPING(c.gender) = 0 THEN 'gender'
+                                    WHEN GROUPING(c.eye_color) = 0 THEN 'eye_color'
+                                    ELSE 'homeworld' END,
+                               COALESCE(CASE WHEN GROUPING(c.gender) = 0 THEN c.gender
+                                             WHEN GROUPING(c.eye_color) = 0 THEN c.eye_color
+                                             ELSE COALESCE(p.url, c.homewor_ld) END, ''),
+                               count(*)` + fromCharacters
+
+var groupCharacters = ` GROUP BY GROUPING SETS ((c.gender), (c.eye_color), (COALESCE(p.url, c.homewor_ld)))
+							ORDER BY 1, 3 DESC, 2;`
+
+func buildCharacterStatsQueries(query *model.CharacterQuery) (string, string, []any) {
+	builder := newCharacterQueryBuilder(query)
+	where := builder.where()
+
+	return selectCharacterMeasures + where + ";", selectCharacterGroups + where + groupCharacters, builder.args
+}
+
+func (a *StarwarRepositoryAdapter) FindCharacterStats(query *model.CharacterQuery, ctx context.Context) (*model.CharacterStats, error) {
+
+	ctx = withOperation(ctx, "find_character_stats")
+
+	log.Printf("FindCharacterStats: %+v\n", query)
+
+	measuresSql, groupsSql, args := buildCharacterStatsQueries(query)
+
+	stats := &model.CharacterStats{
+		ByGender:    []model.GroupCount{},
+		ByEyeColor:  []model.GroupCount{},
+		ByHomeworld: []model.GroupCount{},
+		Height:      model.MeasureStats{Unit: model.HeightUnit},
+		Mass:        model.MeasureStats{Unit: model.MassUnit},
+	}
+
+	err := a.querier(ctx).QueryRow(ctx, measuresSql, args...).Scan(
+		&stats.Total,
+		&stats.Height.Min,
+		&stats.Height.Max,
+		&stats.Height.Avg,
+		&stats.Height.Samples,
+		&stats.Mass.Min,
+		&stats.Mass.Max,
+		&stats.Mass.Avg,
+		&stats.Mass.Samples,
+	)
+	if err != nil {
+		log.Printf("Error finding character statistics: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error finding character statistics: %s\n", err.Error()),
+		}
+	}
+
+	rows, err := a.querier(ctx).Query(ctx, groupsSql, args...)
+	if err != nil {
+		log.Printf("Error finding character statistics: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error finding character statistics: %s\n", err.Error()),
+		}
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var grouping string
+		groupCount := model.GroupCount{}
+
+		if scanErr := rows.Scan(&grouping, &groupCount.Value, &groupCount.Count); scanErr != nil {
+			log.Printf("Error reading character statistics: %s\n", scanErr.Error())
+			return nil, pkg.GenericException{
+				StatusCode: http.StatusInternalServerError,
+				Msj:        fmt.Sprintf("Error reading character statistics: %s\n", scanErr.Error()),
+			}
+		}
+
+		switch grouping {
+		case "gender":
+			stats.ByGender = append(stats.ByGender, groupCount)
+		case "eye_color":
+			stats.ByEyeColor = append(stats.ByEyeColor, groupCount)
+		default:
+			stats.ByHomeworld = append(stats.ByHomeworld, groupCount)
+		}
+	}
+
+	if rows.Err() != nil {
+		log.Printf("Error reading character statistics: %s\n", rows.Err().Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error reading character statistics: %s\n", rows.Err().Error()),
+		}
+	}
+
+	log.Printf("Found statistics of %d characters\n", stats.Total)
+
+	return stats, nil
+}
@@ -0,0 +1,39 @@
+package respository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"handler/function/internal/application/model"
+	"strings"
+	"testing"
+)
+
+func TestBuildCharacterStatsQueries(t *testing.T) {
+
+	minHeight := 150.0
+	filter, err := model.ParseFilter(`gender eq "female"`)
+	assert.Nil(t, err)
+
+	query := &model.CharacterQuery{
+		Height: model.RangeFilter{Min: &minHeight},
+		Filter: filter,
+		Sort:   []model.SortOrder{{Field: "name"}},
+		Limit:  10,
+		Offset: 20,
+	}
+
+	measuresSql, groupsSql, args := buildCharacterStatsQueries(query)
+
+	assert.True(t, strings.HasSuffix(measuresSql, " WHERE c.height >= $1 AND (c.gender = $2);"))
+	assert.Contains(t, groupsSql, " WHERE c.height >= $1 AND (c.gender = $2) GROUP BY GROUPING SETS")
+	assert.NotContains(t, groupsSql, "LIMIT")
+	assert.Equal(t, []any{150.0, "female"}, args)
+}
+
+func TestBuildCharacterStatsQueriesWithoutFilters(t *testing.T) {
+
+	measuresSql, groupsSql, args := buildCharacterStatsQueries(&model.CharacterQuery{})
+
+	assert.NotContains(t, measuresSql, "WHERE")
+	assert.NotContains(t, groupsSql, "WHERE")
+	assert.Empty(t, args)
+}
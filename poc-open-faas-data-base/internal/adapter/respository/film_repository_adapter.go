@@ -0,0 +1,190 @@
+package respository
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+)
+
+var _ out.LinkedResourceRepository[*model.Film, *model.FilmIdentifier, *model.FilmDetail] = (*FilmRepositoryAdapter)(nil)
+
+var insertFilm = `INSERT INTO starwar.film (
+                               title,
+                               episode_id,
+                               opening_crawl,
+                               director,
+                               producer,
+                               release_date,
+                               created,
+                               edited,
+                               url) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,NULLIF($9, '')) RETURNING Id;`
+
+var selectFilmColumns = `SELECT  r.id,
+                               r.title,
+                               r.episode_id,
+                               r.opening_crawl,
+                               r.director,
+                               r.producer,
+                               r.release_date,
+                               r.created,
+                               r.edited,
+                               COALESCE(r.url, '')
+							FROM starwar.film r`
+
+var selectFilm = selectFilmColumns + `
+							WHERE r.id = $1;`
+
+var selectFilmsByCharacter = selectFilmColumns + `
+							JOIN starwar.character_film l ON l.film_id = r.id
+							WHERE l.character_id = $1
+							ORDER BY r.id;`
+
+type FilmRepositoryAdapter struct {
+	pool *pgxpool.Pool
+}
+
+func NewFilmRepositoryAdapter(p *pgxpool.Pool) (*FilmRepositoryAdapter, error) {
+	return &FilmRepositoryAdapter{pool: p}, nil
+}
+
+func (a *FilmRepositoryAdapter) querier(ctx context.Context) querier {
+	return querierFromContext(a.pool, ctx)
+}
+
+func (a *FilmRepositoryAdapter) Create(film *model.Film, ctx context.Context) (*model.FilmIdentifier, error) {
+	insertResponse := repositoryModel.FilmRepository{}
+	err := a.querier(ctx).QueryRow(ctx,
+		insertFilm,
+		film.Title,
+		film.EpisodeId,
+		film.OpeningCrawl,
+		film.Director,
+		film.Producer,
+		film.ReleaseDate,
+		film.Created,
+		film.Edited,
+		film.Url).
+		Scan(&insertResponse.Id)
+
+	if isUniqueViolation(err) {
+		return nil, duplicatedUrl("Film", film.Url)
+	}
+
+	if err != nil {
+		log.Printf("Error creating a new film %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error creating a new film %s\n", err.Error()),
+		}
+	}
+
+	log.Printf("New film Id: %d\n", insertResponse.Id)
+
+	return &model.FilmIdentifier{
+		Id: insertResponse.Id,
+	}, nil
+}
+
+func (a *FilmRepositoryAdapter) FindById(film *model.FilmIdentifier, ctx context.Context) (*model.FilmDetail, error) {
+
+	log.Printf("FindFilmById: %d\n", film.Id)
+
+	findResponse, err := scanFilm(a.querier(ctx).QueryRow(ctx, selectFilm, film.Id))
+
+	if err != nil {
+		log.Printf("Error finding a film: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusNotFound,
+			Msj:        fmt.Sprintf("Film Not Found: %s\n", err.Error()),
+		}
+	}
+
+	return filmToDomain(findResponse), nil
+}
+
+func (a *FilmRepositoryAdapter) FindByCharacter(character *model.CharacterIdentifier, ctx context.Context) ([]*model.FilmDetail, error) {
+
+	log.Printf("FindFilmsByCharacter: %d\n", character.Id)
+
+	if err := characterExists(a.querier(ctx), character, ctx); err != nil {
+		return nil, err
+	}
+
+	rows, err := a.querier(ctx).Query(ctx, selectFilmsByCharacter, character.Id)
+	if err != nil {
+		log.Printf("Error finding character films: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error finding character films: %s\n", err.Error()),
+		}
+	}
+	defer rows.Close()
+
+	filmDetails := []*model.FilmDetail{}
+
+	for rows.Next() {
+		findResponse, scanErr := scanFilm(rows)
+
+		if scanErr != nil {
+			log.Printf("Error reading character films: %s\n", scanErr.Error())
+			return nil, pkg.GenericException{
+				StatusCode: http.StatusInternalServerError,
+				Msj:        fmt.Sprintf("Error reading character films: %s\n", scanErr.Error()),
+			}
+		}
+
+		filmDetails = append(filmDetails, filmToDomain(findResponse))
+	}
+
+	if rows.Err() != nil {
+		log.Printf("Error reading character films: %s\n", rows.Err().Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error reading character films: %s\n", rows.Err().Error()),
+		}
+	}
+
+	return filmDetails, nil
+}
+
+func scanFilm(row pgx.Row) (*repositoryModel.FilmRepository, error) {
+	findResponse := repositoryModel.FilmRepository{}
+	err := row.Scan(
+		&findResponse.Id,
+		&findResponse.Title,
+		&findResponse.EpisodeId,
+		&findResponse.OpeningCrawl,
+		&findResponse.Director,
+		&findResponse.Producer,
+		&findResponse.ReleaseDate,
+		&findResponse.Created,
+		&findResponse.Edited,
+		&findResponse.Url,
+	)
+
+	return &findResponse, err
+}
+
+func filmToDomain(r *repositoryModel.FilmRepository) *model.FilmDetail {
+	return &model.FilmDetail{
+		Id: &model.FilmIdentifier{Id: r.Id},
+		Film: &model.Film{
+			Title:        r.Title,
+			EpisodeId:    r.EpisodeId,
+			OpeningCrawl: r.OpeningCrawl,
+			Director:     r.Director,
+			Producer:     r.Producer,
+			ReleaseDate:  r.ReleaseDate,
+			Created:      r.Created,
+			Edited:       r.Edited,
+			Url:          r.Url,
+		},
+	}
+}
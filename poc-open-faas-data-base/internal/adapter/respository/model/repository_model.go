@@ -1,21 +1,125 @@
 package model
 
+import "time"
+
 type CharacterIdentifier struct {
 	Id int
 }
 
 type CharacterRepository struct {
-	Id        int
-	Name      string
-	Height    string
-	Mass      string
-	HairColor string
-	SkinColor string
-	EyeColor  string
-	BirthYear string
-	Gender    string
-	Homeworld string
-	Created   string
-	Edited    string
-	Url       string
+	Id             int
+	Name           string
+	Height         *float64
+	Mass           *float64
+	HairColor      string
+	SkinColor      string
+	EyeColor       string
+	BirthYear      string
+	BirthYearValue *float64
+	Gender         string
+	Homeworld      string
+	HomeworldId    *int
+	Created        time.Time
+	Edited         time.Time
+	Url            string
+	Version        int
+}
+
+type PoolOptions struct {
+	MaxConns          int32
+	MinConns          int32
+	MaxConnLifetime   time.Duration
+	MaxConnIdleTime   time.Duration
+	HealthCheckPeriod time.Duration
+	// QueryObserver records the latency and the errors of every query, it is optional.
+	QueryObserver QueryObserver
+}
+
+type QueryObserver interface {
+	ObserveQuery(operation string, duration time.Duration, err error)
+}
+
+type PlanetRepository struct {
+	Id             int
+	Name           string
+	RotationPeriod string
+	OrbitalPeriod  string
+	Diameter       string
+	Climate        string
+	Gravity        string
+	Terrain        string
+	SurfaceWater   string
+	Population     string
+	Created        time.Time
+	Edited         time.Time
+	Url            string
+	Version        int
+}
+
+type FilmRepository struct {
+	Id           int
+	Title        string
+	EpisodeId    int
+	OpeningCrawl string
+	Director     string
+	Producer     string
+	ReleaseDate  string
+	Created      time.Time
+	Edited       time.Time
+	Url          string
+}
+
+type SpeciesRepository struct {
+	Id              int
+	Name            string
+	Classification  string
+	Designation     string
+	AverageHeight   string
+	SkinColors      string
+	HairColors      string
+	EyeColors       string
+	AverageLifespan string
+	Homeworld       string
+	Language        string
+	Created         time.Time
+	Edited          time.Time
+	Url             string
+}
+
+type VehicleRepository struct {
+	Id                   int
+	Name                 string
+	Model                string
+	Manufacturer         string
+	CostInCredits        string
+	Length               string
+	MaxAtmospheringSpeed string
+	Crew                 string
+	Passengers           string
+	CargoCapacity        string
+	Consumables          string
+	VehicleClass         string
+	Created              time.Time
+	Edited               time.Time
+	Url                  string
+}
+
+type StarshipRepository struct {
+	Id                   int
+	Name                 string
+	Model                string
+	Manufacturer         string
+	CostInCredits        string
+	Length               string
+	MaxAtmospheringSpeed string
+	Crew                 string
+	Passengers           string
+	CargoCapacity        string
+	Consumables          string
+	HyperdriveRating     string
+	MGLT                 string
+	StarshipClass        string
+	Created              time.Time
+	Edited               time.Time
+	Url                  string
 }
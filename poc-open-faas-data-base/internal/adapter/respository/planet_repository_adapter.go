@@ -0,0 +1,270 @@
+package respository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+	"time"
+)
+
+var _ out.PlanetRepository = (*PlanetRepositoryAdapter)(nil)
+
+var insertPlanet = `INSERT INTO starwar.planet (
+                               name,
+                               rotation_period,
+                               orbital_period,
+                               diameter,
+                               climate,
+                               gravity,
+                               terrain,
+                               surface_water,
+                               population,
+                               created,
+                               edited,
+                               url) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,NULLIF($12, '')) RETURNING Id;`
+
+var selectPlanet = `SELECT  name,
+                               rotation_period,
+                               orbital_period,
+                               diameter,
+                               climate,
+                               gravity,
+                               terrain,
+                               surface_water,
+                               population,
+                               created,
+                               edited,
+                               COALESCE(url, ''),
+                               version
+							FROM starwar.planet
+							WHERE id = $1;`
+
+var updatePlanet = `UPDATE starwar.planet SET
+                               name = $2,
+                               rotation_period = $3,
+                               orbital_period = $4,
+                               diameter = $5,
+                               climate = $6,
+                               gravity = $7,
+                               terrain = $8,
+                               surface_water = $9,
+                               population = $10,
+                               edited = $11,
+                               url = NULLIF($12, ''),
+                               version = version + 1
+							WHERE id = $1 AND ($13 = 0 OR version = $13)
+							RETURNING version, created;`
+
+var selectPlanetVersion = `SELECT version FROM starwar.planet WHERE id = $1;`
+
+var deletePlanet = `DELETE FROM starwar.planet WHERE id = $1;`
+
+type PlanetRepositoryAdapter struct {
+	pool *pgxpool.Pool
+}
+
+func NewPlanetRepositoryAdapter(p *pgxpool.Pool) (*PlanetRepositoryAdapter, error) {
+	return &PlanetRepositoryAdapter{pool: p}, nil
+}
+
+func (a *PlanetRepositoryAdapter) querier(ctx context.Context) querier {
+	return querierFromContext(a.pool, ctx)
+}
+
+func (a *PlanetRepositoryAdapter) CreatePlanet(planet *model.Planet, ctx context.Context) (*model.PlanetIdentifier, error) {
+	insertResponse := repositoryModel.PlanetRepository{}
+	err := a.querier(ctx).QueryRow(ctx,
+		insertPlanet,
+		planet.Name,
+		planet.RotationPeriod,
+		planet.OrbitalPeriod,
+		planet.Diameter,
+		planet.Climate,
+		planet.Gravity,
+		planet.Terrain,
+		planet.SurfaceWater,
+		planet.Population,
+		planet.Created,
+		planet.Edited,
+		planet.Url).
+		Scan(&insertResponse.Id)
+
+	if isUniqueViolation(err) {
+		return nil, duplicatedUrl("Planet", planet.Url)
+	}
+
+	if err != nil {
+		log.Printf("Error creating a new planet %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error creating a new planet %s\n", err.Error()),
+		}
+	}
+
+	log.Printf("New planet Id: %d\n", insertResponse.Id)
+
+	return &model.PlanetIdentifier{
+		Id: insertResponse.Id,
+	}, nil
+}
+
+func (a *PlanetRepositoryAdapter) FindPlanetById(planet *model.PlanetIdentifier, ctx context.Context) (*model.PlanetDetail, error) {
+
+	log.Printf("FindPlanetById: %d\n", planet.Id)
+
+	findResponse := repositoryModel.PlanetRepository{Id: planet.Id}
+	err := a.querier(ctx).QueryRow(ctx, selectPlanet, planet.Id).
+		Scan(
+			&findResponse.Name,
+			&findResponse.RotationPeriod,
+			&findResponse.OrbitalPeriod,
+			&findResponse.Diameter,
+			&findResponse.Climate,
+			&findResponse.Gravity,
+			&findResponse.Terrain,
+			&findResponse.SurfaceWater,
+			&findResponse.Population,
+			&findResponse.Created,
+			&findResponse.Edited,
+			&findResponse.Url,
+			&findResponse.Version,
+		)
+
+	if err != nil {
+		log.Printf("Error finding a planet: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusNotFound,
+			Msj:        fmt.Sprintf("Planet Not Found: %s\n", err.Error()),
+		}
+	}
+
+	return planetToDomain(&findResponse), nil
+}
+
+func (a *PlanetRepositoryAdapter) UpdatePlanet(planet *model.PlanetDetail, ctx context.Context) (*model.PlanetDetail, error) {
+
+	log.Printf("UpdatePlanet: %d version: %d\n", planet.Id.Id, planet.Version)
+
+	var version int
+	var created time.Time
+	err := a.querier(ctx).QueryRow(ctx,
+		updatePlanet,
+		planet.Id.Id,
+		planet.Planet.Name,
+		planet.Planet.RotationPeriod,
+		planet.Planet.OrbitalPeriod,
+		planet.Planet.Diameter,
+		planet.Planet.Climate,
+		planet.Planet.Gravity,
+		planet.Planet.Terrain,
+		planet.Planet.SurfaceWater,
+		planet.Planet.Population,
+		planet.Planet.Edited,
+		planet.Planet.Url,
+		planet.Version).
+		Scan(&version, &created)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, a.updateConflict(planet, ctx)
+	}
+
+	if isUniqueViolation(err) {
+		return nil, duplicatedUrl("Planet", planet.Planet.Url)
+	}
+
+	if err != nil {
+		log.Printf("Error updating planet %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error updating planet %s\n", err.Error()),
+		}
+	}
+
+	updatedPlanet := *planet.Planet
+	updatedPlanet.Created = created
+
+	return &model.PlanetDetail{
+		Id:      planet.Id,
+		Planet:  &updatedPlanet,
+		Version: version,
+	}, nil
+}
+
+func (a *PlanetRepositoryAdapter) updateConflict(planet *model.PlanetDetail, ctx context.Context) error {
+
+	var currentVersion int
+	err := a.querier(ctx).QueryRow(ctx, selectPlanetVersion, planet.Id.Id).Scan(&currentVersion)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return pkg.GenericException{
+			StatusCode: http.StatusNotFound,
+			Msj:        fmt.Sprintf("Planet Not Found: %d\n", planet.Id.Id),
+		}
+	}
+
+	if err != nil {
+		log.Printf("Error reading planet version %s\n", err.Error())
+		return pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error updating planet %s\n", err.Error()),
+		}
+	}
+
+	return pkg.GenericException{
+		StatusCode: http.StatusPreconditionFailed,
+		Msj:        fmt.Sprintf("Planet %d was modified, current version: %d\n", planet.Id.Id, currentVersion),
+	}
+}
+
+func (a *PlanetRepositoryAdapter) DeletePlanet(planet *model.PlanetIdentifier, ctx context.Context) error {
+
+	log.Printf("DeletePlanet: %d\n", planet.Id)
+
+	commandTag, err := a.querier(ctx).Exec(ctx, deletePlanet, planet.Id)
+
+	if err != nil {
+		log.Printf("Error deleting planet %s\n", err.Error())
+		return pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error deleting planet %s\n", err.Error()),
+		}
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return pkg.GenericException{
+			StatusCode: http.StatusNotFound,
+			Msj:        fmt.Sprintf("Planet Not Found: %d\n", planet.Id),
+		}
+	}
+
+	return nil
+}
+
+func planetToDomain(p *repositoryModel.PlanetRepository) *model.PlanetDetail {
+	return &model.PlanetDetail{
+		Id: &model.PlanetIdentifier{Id: p.Id},
+		Planet: &model.Planet{
+			Name:           p.Name,
+			RotationPeriod: p.RotationPeriod,
+			OrbitalPeriod:  p.OrbitalPeriod,
+			Diameter:       p.Diameter,
+			Climate:        p.Climate,
+			Gravity:        p.Gravity,
+			Terrain:        p.Terrain,
+			SurfaceWater:   p.SurfaceWater,
+			Population:     p.Population,
+			Created:        p.Created,
+			Edited:         p.Edited,
+			Url:            p.Url,
+		},
+		Version: p.Version,
+	}
+}
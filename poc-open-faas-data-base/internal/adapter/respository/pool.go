@@ -0,0 +1,44 @@
+package respository
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgxpool"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"log"
+)
+
+// NewPool builds the pgx pool from the database url, overriding the pgx defaults
+// only for the options that were configured (zero values keep the defaults).
+func NewPool(databaseUrl string, options *repositoryModel.PoolOptions, ctx context.Context) (*pgxpool.Pool, error) {
+
+	poolConfig, err := pgxpool.ParseConfig(databaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.MaxConns > 0 {
+		poolConfig.MaxConns = options.MaxConns
+	}
+	if options.MinConns > 0 {
+		poolConfig.MinConns = options.MinConns
+	}
+	if options.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = options.MaxConnLifetime
+	}
+	if options.MaxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = options.MaxConnIdleTime
+	}
+	if options.HealthCheckPeriod > 0 {
+		poolConfig.HealthCheckPeriod = options.HealthCheckPeriod
+	}
+	poolConfig.ConnConfig.Tracer = &queryTracer{observer: options.QueryObserver}
+
+	log.Printf("Data base pool max conns: %d, min conns: %d, max conn lifetime: %s, max conn idle time: %s, health check period: %s\n",
+		poolConfig.MaxConns,
+		poolConfig.MinConns,
+		poolConfig.MaxConnLifetime,
+		poolConfig.MaxConnIdleTime,
+		poolConfig.HealthCheckPeriod)
+
+	return pgxpool.NewWithConfig(ctx, poolConfig)
+}
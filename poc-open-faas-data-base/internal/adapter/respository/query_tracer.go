@@ -0,0 +1,79 @@
+package respository
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"strings"
+	"time"
+)
+
+// unnamedOperation labels the queries of adapters that do not name their operations.
+const unnamedOperation = "other"
+
+var tracer = otel.Tracer("handler/function/internal/adapter/respository")
+
+type operationKey struct{}
+
+type queryStartKey struct{}
+
+// withOperation names the queries run with ctx after the repository operation that runs them.
+func withOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+func operationFromContext(ctx context.Context) string {
+	if operation, ok := ctx.Value(operationKey{}).(string); ok {
+		return operation
+	}
+	return unnamedOperation
+}
+
+// queryTracer runs every query of the pool in a span and, with an observer, times it
+// from it is sent until its rows are closed.
+type queryTracer struct {
+	observer repositoryModel.QueryObserver
+}
+
+func (t *queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	operation := operationFromContext(ctx)
+
+	ctx, _ = tracer.Start(ctx, "postgres "+operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		semconv.DBSystemPostgreSQL,
+		semconv.DBOperationKey.String(sqlOperation(data.SQL)),
+		semconv.DBStatementKey.String(data.SQL),
+		attribute.String("repository.operation", operation)))
+
+	return context.WithValue(ctx, queryStartKey{}, time.Now())
+}
+
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	}
+	span.End()
+
+	start, ok := ctx.Value(queryStartKey{}).(time.Time)
+	if !ok || t.observer == nil {
+		return
+	}
+	t.observer.ObserveQuery(operationFromContext(ctx), time.Since(start), data.Err)
+}
+
+// sqlOperation is the first keyword of the statement, such as SELECT or INSERT, comment lines are skipped.
+func sqlOperation(sql string) string {
+	for _, line := range strings.Split(sql, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && !strings.HasPrefix(fields[0], "--") {
+			return strings.ToUpper(fields[0])
+		}
+	}
+	return ""
+}
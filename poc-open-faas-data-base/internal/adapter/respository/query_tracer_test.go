@@ -0,0 +1,129 @@
+package respository
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"testing"
+	"time"
+)
+
+var spanExporter = tracetest.NewInMemoryExporter()
+
+func init() {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(spanExporter)))
+}
+
+type QueryObserverMock struct {
+	mock.Mock
+}
+
+func (m *QueryObserverMock) ObserveQuery(operation string, duration time.Duration, err error) {
+	m.Called(operation, duration, err)
+}
+
+func TestQueryTracer(t *testing.T) {
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		err       error
+		operation string
+	}
+
+	testCases := []testCase{
+		{name: "named operation", ctx: withOperation(context.Background(), "find_character"), operation: "find_character"},
+		{name: "failed query", ctx: withOperation(context.Background(), "update_character"), err: errors.New("deadlock detected"), operation: "update_character"},
+		{name: "unnamed operation", ctx: context.Background(), operation: unnamedOperation},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			observer := QueryObserverMock{}
+			observer.On("ObserveQuery", test.operation, mock.AnythingOfType("time.Duration"), test.err).Return()
+
+			tracer := &queryTracer{observer: &observer}
+
+			ctx := tracer.TraceQueryStart(test.ctx, nil, pgx.TraceQueryStartData{SQL: selectCharacter})
+			tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: test.err})
+
+			observer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestQueryTracerWithoutStart(t *testing.T) {
+	observer := QueryObserverMock{}
+
+	tracer := &queryTracer{observer: &observer}
+	tracer.TraceQueryEnd(context.Background(), nil, pgx.TraceQueryEndData{})
+
+	observer.AssertNotCalled(t, "ObserveQuery", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestQueryTracerSpans(t *testing.T) {
+
+	type testCase struct {
+		name      string
+		ctx       context.Context
+		err       error
+		spanName  string
+		operation string
+		status    codes.Code
+	}
+
+	testCases := []testCase{
+		{name: "named operation", ctx: withOperation(context.Background(), "find_character"), spanName: "postgres find_character", operation: "find_character", status: codes.Unset},
+		{name: "failed query", ctx: withOperation(context.Background(), "update_character"), err: errors.New("deadlock detected"), spanName: "postgres update_character", operation: "update_character", status: codes.Error},
+		{name: "unnamed operation", ctx: context.Background(), spanName: "postgres other", operation: unnamedOperation, status: codes.Unset},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			spanExporter.Reset()
+
+			tracer := &queryTracer{}
+
+			ctx := tracer.TraceQueryStart(test.ctx, nil, pgx.TraceQueryStartData{SQL: selectCharacter})
+			tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: test.err})
+
+			spans := spanExporter.GetSpans()
+			if assert.Len(t, spans, 1) {
+				assert.Equal(t, test.spanName, spans[0].Name)
+				assert.Equal(t, test.status, spans[0].Status.Code)
+				assert.Contains(t, spans[0].Attributes, attribute.String("db.system", "postgresql"))
+				assert.Contains(t, spans[0].Attributes, attribute.String("db.operation", "SELECT"))
+				assert.Contains(t, spans[0].Attributes, attribute.String("repository.operation", test.operation))
+			}
+		})
+	}
+}
+
+func TestSqlOperation(t *testing.T) {
+
+	type testCase struct {
+		name      string
+		sql       string
+		operation string
+	}
+
+	testCases := []testCase{
+		{name: "select", sql: "select name from character where id = $1", operation: "SELECT"},
+		{name: "leading lines", sql: "\n\t  INSERT INTO character (name)\n VALUES ($1)", operation: "INSERT"},
+		{name: "comment", sql: "-- find the character\nUPDATE character SET name = $1", operation: "UPDATE"},
+		{name: "empty", sql: "", operation: ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.operation, sqlOperation(test.sql))
+		})
+	}
+}
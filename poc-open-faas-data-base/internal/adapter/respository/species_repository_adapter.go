@@ -0,0 +1,210 @@
+package respository
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+)
+
+var _ out.LinkedResourceRepository[*model.Species, *model.SpeciesIdentifier, *model.SpeciesDetail] = (*SpeciesRepositoryAdapter)(nil)
+
+var insertSpecies = `INSERT INTO starwar.species (
+                               name,
+                               classification,
+                               designation,
+                               average_height,
+                               skin_colors,
+                               hair_colors,
+                               eye_colors,
+                               average_lifespan,
+                               homeworld,
+                               language,
+                               created,
+                               edited,
+                               url) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,NULLIF($13, '')) RETURNING Id;`
+
+var selectSpeciesColumns = `SELECT  r.id,
+                               r.name,
+                               r.classification,
+                               r.designation,
+                               r.average_height,
+                               r.skin_colors,
+                               r.hair_colors,
+                               r.eye_colors,
+                               r.average_lifespan,
+                               r.homeworld,
+                               r.language,
+                               r.created,
+                               r.edited,
+                               COALESCE(r.url, '')
+							FROM starwar.species r`
+
+var selectSpecies = selectSpeciesColumns + `
+							WHERE r.id = $1;`
+
+var selectSpeciesByCharacter = selectSpeciesColumns + `
+							JOIN starwar.character_species l ON l.species_id = r.id
+							WHERE l.character_id = $1
+							ORDER BY r.id;`
+
+type SpeciesRepositoryAdapter struct {
+	pool *pgxpool.Pool
+}
+
+func NewSpeciesRepositoryAdapter(p *pgxpool.Pool) (*SpeciesRepositoryAdapter, error) {
+	return &SpeciesRepositoryAdapter{pool: p}, nil
+}
+
+func (a *SpeciesRepositoryAdapter) querier(ctx context.Context) querier {
+	return querierFromContext(a.pool, ctx)
+}
+
+func (a *SpeciesRepositoryAdapter) Create(species *model.Species, ctx context.Context) (*model.SpeciesIdentifier, error) {
+	insertResponse := repositoryModel.SpeciesRepository{}
+	err := a.querier(ctx).QueryRow(ctx,
+		insertSpecies,
+		species.Name,
+		species.Classification,
+		species.Designation,
+		species.AverageHeight,
+		species.SkinColors,
+		species.HairColors,
+		species.EyeColors,
+		species.AverageLifespan,
+		species.Homeworld,
+		species.Language,
+		species.Created,
+		species.Edited,
+		species.Url).
+		Scan(&insertResponse.Id)
+
+	if isUniqueViolation(err) {
+		return nil, duplicatedUrl("Species", species.Url)
+	}
+
+	if err != nil {
+		log.Printf("Error creating a new species %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error creating a new species %s\n", err.Error()),
+		}
+	}
+
+	log.Printf("New species Id: %d\n", insertResponse.Id)
+
+	return &model.SpeciesIdentifier{
+		Id: insertResponse.Id,
+	}, nil
+}
+
+func (a *SpeciesRepositoryAdapter) FindById(species *model.SpeciesIdentifier, ctx context.Context) (*model.SpeciesDetail, error) {
+
+	log.Printf("FindSpeciesById: %d\n", species.Id)
+
+	findResponse, err := scanSpecies(a.querier(ctx).QueryRow(ctx, selectSpecies, species.Id))
+
+	if err != nil {
+		log.Printf("Error finding a species: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusNotFound,
+			Msj:        fmt.Sprintf("Species Not Found: %s\n", err.Error()),
+		}
+	}
+
+	return speciesToDomain(findResponse), nil
+}
+
+func (a *SpeciesRepositoryAdapter) FindByCharacter(character *model.CharacterIdentifier, ctx context.Context) ([]*model.SpeciesDetail, error) {
+
+	log.Printf("FindSpeciesByCharacter: %d\n", character.Id)
+
+	if err := characterExists(a.querier(ctx), character, ctx); err != nil {
+		return nil, err
+	}
+
+	rows, err := a.querier(ctx).Query(ctx, selectSpeciesByCharacter, character.Id)
+	if err != nil {
+		log.Printf("Error finding character species: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error finding character species: %s\n", err.Error()),
+		}
+	}
+	defer rows.Close()
+
+	speciesDetails := []*model.SpeciesDetail{}
+
+	for rows.Next() {
+		findResponse, scanErr := scanSpecies(rows)
+
+		if scanErr != nil {
+			log.Printf("Error reading character species: %s\n", scanErr.Error())
+			return nil, pkg.GenericException{
+				StatusCode: http.StatusInternalServerError,
+				Msj:        fmt.Sprintf("Error reading character species: %s\n", scanErr.Error()),
+			}
+		}
+
+		speciesDetails = append(speciesDetails, speciesToDomain(findResponse))
+	}
+
+	if rows.Err() != nil {
+		log.Printf("Error reading character species: %s\n", rows.Err().Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error reading character species: %s\n", rows.Err().Error()),
+		}
+	}
+
+	return speciesDetails, nil
+}
+
+func scanSpecies(row pgx.Row) (*repositoryModel.SpeciesRepository, error) {
+	findResponse := repositoryModel.SpeciesRepository{}
+	err := row.Scan(
+		&findResponse.Id,
+		&findResponse.Name,
+		&findResponse.Classification,
+		&findResponse.Designation,
+		&findResponse.AverageHeight,
+		&findResponse.SkinColors,
+		&findResponse.HairColors,
+		&findResponse.EyeColors,
+		&findResponse.AverageLifespan,
+		&findResponse.Homeworld,
+		&findResponse.Language,
+		&findResponse.Created,
+		&findResponse.Edited,
+		&findResponse.Url,
+	)
+
+	return &findResponse, err
+}
+
+func speciesToDomain(r *repositoryModel.SpeciesRepository) *model.SpeciesDetail {
+	return &model.SpeciesDetail{
+		Id: &model.SpeciesIdentifier{Id: r.Id},
+		Species: &model.Species{
+			Name:            r.Name,
+			Classification:  r.Classification,
+			Designation:     r.Designation,
+			AverageHeight:   r.AverageHeight,
+			SkinColors:      r.SkinColors,
+			HairColors:      r.HairColors,
+			EyeColors:       r.EyeColors,
+			AverageLifespan: r.AverageLifespan,
+			Homeworld:       r.Homeworld,
+			Language:        r.Language,
+			Created:         r.Created,
+			Edited:          r.Edited,
+			Url:             r.Url,
+		},
+	}
+}
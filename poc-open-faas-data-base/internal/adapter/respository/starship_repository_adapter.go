@@ -0,0 +1,225 @@
+package respository
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+)
+
+var _ out.LinkedResourceRepository[*model.Starship, *model.StarshipIdentifier, *model.StarshipDetail] = (*StarshipRepositoryAdapter)(nil)
+
+var insertStarship = `INSERT INTO starwar.starship (
+                               name,
+                               model,
+                               manufacturer,
+                               cost_in_credits,
+                               length,
+                               max_atmosphering_speed,
+                               crew,
+                               passengers,
+                               cargo_capacity,
+                               consumables,
+                               hyperdrive_rating,
+                               mglt,
+                               starship_class,
+                               created,
+                               edited,
+                               url) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,NULLIF($16, '')) RETURNING Id;`
+
+var selectStarshipColumns = `SELECT  r.id,
+                               r.name,
+                               r.model,
+                               r.manufacturer,
+                               r.cost_in_credits,
+                               r.length,
+                               r.max_atmosphering_speed,
+                               r.crew,
+                               r.passengers,
+                               r.cargo_capacity,
+                               r.consumables,
+                               r.hyperdrive_rating,
+                               r.mglt,
+                               r.starship_class,
+                               r.created,
+                               r.edited,
+                               COALESCE(r.url, '')
+							FROM starwar.starship r`
+
+var selectStarship = selectStarshipColumns + `
+							WHERE r.id = $1;`
+
+var selectStarshipsByCharacter = selectStarshipColumns + `
+							JOIN starwar.character_starship l ON l.starship_id = r.id
+							WHERE l.character_id = $1
+							ORDER BY r.id;`
+
+type StarshipRepositoryAdapter struct {
+	pool *pgxpool.Pool
+}
+
+func NewStarshipRepositoryAdapter(p *pgxpool.Pool) (*StarshipRepositoryAdapter, error) {
+	return &StarshipRepositoryAdapter{pool: p}, nil
+}
+
+func (a *StarshipRepositoryAdapter) querier(ctx context.Context) querier {
+	return querierFromContext(a.pool, ctx)
+}
+
+func (a *StarshipRepositoryAdapter) Create(starship *model.Starship, ctx context.Context) (*model.StarshipIdentifier, error) {
+	insertResponse := repositoryModel.StarshipRepository{}
+	err := a.querier(ctx).QueryRow(ctx,
+		insertStarship,
+		starship.Name,
+		starship.Model,
+		starship.Manufacturer,
+		starship.CostInCredits,
+		starship.Length,
+		starship.MaxAtmospheringSpeed,
+		starship.Crew,
+		starship.Passengers,
+		starship.CargoCapacity,
+		starship.Consumables,
+		starship.HyperdriveRating,
+		starship.MGLT,
+		starship.StarshipClass,
+		starship.Created,
+		starship.Edited,
+		starship.Url).
+		Scan(&insertResponse.Id)
+
+	if isUniqueViolation(err) {
+		return nil, duplicatedUrl("Starship", starship.Url)
+	}
+
+	if err != nil {
+		log.Printf("Error creating a new starship %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error creating a new starship %s\n", err.Error()),
+		}
+	}
+
+	log.Printf("New starship Id: %d\n", insertResponse.Id)
+
+	return &model.StarshipIdentifier{
+		Id: insertResponse.Id,
+	}, nil
+}
+
+func (a *StarshipRepositoryAdapter) FindById(starship *model.StarshipIdentifier, ctx context.Context) (*model.StarshipDetail, error) {
+
+	log.Printf("FindStarshipById: %d\n", starship.Id)
+
+	findResponse, err := scanStarship(a.querier(ctx).QueryRow(ctx, selectStarship, starship.Id))
+
+	if err != nil {
+		log.Printf("Error finding a starship: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusNotFound,
+			Msj:        fmt.Sprintf("Starship Not Found: %s\n", err.Error()),
+		}
+	}
+
+	return starshipToDomain(findResponse), nil
+}
+
+func (a *StarshipRepositoryAdapter) FindByCharacter(character *model.CharacterIdentifier, ctx context.Context) ([]*model.StarshipDetail, error) {
+
+	log.Printf("FindStarshipsByCharacter: %d\n", character.Id)
+
+	if err := characterExists(a.querier(ctx), character, ctx); err != nil {
+		return nil, err
+	}
+
+	rows, err := a.querier(ctx).Query(ctx, selectStarshipsByCharacter, character.Id)
+	if err != nil {
+		log.Printf("Error finding character starships: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error finding character starships: %s\n", err.Error()),
+		}
+	}
+	defer rows.Close()
+
+	starshipDetails := []*model.StarshipDetail{}
+
+	for rows.Next() {
+		findResponse, scanErr := scanStarship(rows)
+
+		if scanErr != nil {
+			log.Printf("Error reading character starships: %s\n", scanErr.Error())
+			return nil, pkg.GenericException{
+				StatusCode: http.StatusInternalServerError,
+				Msj:        fmt.Sprintf("Error reading character starships: %s\n", scanErr.Error()),
+			}
+		}
+
+		starshipDetails = append(starshipDetails, starshipToDomain(findResponse))
+	}
+
+	if rows.Err() != nil {
+		log.Printf("Error reading character starships: %s\n", rows.Err().Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error reading character starships: %s\n", rows.Err().Error()),
+		}
+	}
+
+	return starshipDetails, nil
+}
+
+func scanStarship(row pgx.Row) (*repositoryModel.StarshipRepository, error) {
+	findResponse := repositoryModel.StarshipRepository{}
+	err := row.Scan(
+		&findResponse.Id,
+		&findResponse.Name,
+		&findResponse.Model,
+		&findResponse.Manufacturer,
+		&findResponse.CostInCredits,
+		&findResponse.Length,
+		&findResponse.MaxAtmospheringSpeed,
+		&findResponse.Crew,
+		&findResponse.Passengers,
+		&findResponse.CargoCapacity,
+		&findResponse.Consumables,
+		&findResponse.HyperdriveRating,
+		&findResponse.MGLT,
+		&findResponse.StarshipClass,
+		&findResponse.Created,
+		&findResponse.Edited,
+		&findResponse.Url,
+	)
+
+	return &findResponse, err
+}
+
+func starshipToDomain(r *repositoryModel.StarshipRepository) *model.StarshipDetail {
+	return &model.StarshipDetail{
+		Id: &model.StarshipIdentifier{Id: r.Id},
+		Starship: &model.Starship{
+			Name:                 r.Name,
+			Model:                r.Model,
+			Manufacturer:         r.Manufacturer,
+			CostInCredits:        r.CostInCredits,
+			Length:               r.Length,
+			MaxAtmospheringSpeed: r.MaxAtmospheringSpeed,
+			Crew:                 r.Crew,
+			Passengers:           r.Passengers,
+			CargoCapacity:        r.CargoCapacity,
+			Consumables:          r.Consumables,
+			HyperdriveRating:     r.HyperdriveRating,
+			MGLT:                 r.MGLT,
+			StarshipClass:        r.StarshipClass,
+			Created:              r.Created,
+			Edited:               r.Edited,
+			Url:                  r.Url,
+		},
+	}
+}
@@ -2,7 +2,9 @@ package respository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	repositoryModel "handler/function/internal/adapter/respository/model"
 	"handler/function/internal/application/model"
@@ -10,9 +12,13 @@ import (
 	"handler/function/pkg"
 	"log"
 	"net/http"
+	"time"
 )
 
 var _ out.StarwarRepository = (*StarwarRepositoryAdapter)(nil)
+var _ out.UnitOfWork = (*StarwarRepositoryAdapter)(nil)
+var _ out.DatabaseDiagnostic = (*StarwarRepositoryAdapter)(nil)
+var _ out.DependencyPinger = (*StarwarRepositoryAdapter)(nil)
 
 var insertCharacter = `INSERT INTO starwar.character (
                                name,
@@ -22,26 +28,82 @@ var insertCharacter = `INSERT INTO starwar.character (
                                skin_color,
                                eye_color,
                                birth_year,
+                               birth_year_value,
                                gender,
                                homewor_ld,
+                               homeworld_id,
                                created,
                                edited,
-                               url) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING Id;`
+                               url) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,
+                               COALESCE($11::integer, (SELECT id FROM starwar.planet WHERE url = NULLIF($10, ''))),
+                               $12,$13,$14) RETURNING Id;`
 
-var selectCharacter = `SELECT  name,
+var selectCharacter = `SELECT  c.name,
+                               c.height,
+                               c.mass,
+                               c.hair_color,
+                               c.skin_color,
+                               c.eye_color,
+                               c.birth_year,
+                               c.birth_year_value,
+                               c.gender,
+                               COALESCE(p.url, c.homewor_ld),
+                               c.homeworld_id,
+                               c.created,
+                               c.edited,
+                               c.url,
+                               c.version
+							FROM starwar.character c
+							LEFT JOIN starwar.planet p ON p.id = c.homeworld_id
+							WHERE c.id = $1;`
+
+var updateCharacter = `UPDATE starwar.character SET
+                               name = $2,
+                               height = $3,
+                               mass = $4,
+                               hair_color = $5,
+                               skin_color = $6,
+                               eye_color = $7,
+                               birth_year = $8,
+                               birth_year_value = $9,
+                               gender = $10,
+                               homewor_ld = $11,
+                               homeworld_id = COALESCE($12::integer, (SELECT id FROM starwar.planet WHERE url = NULLIF($11, ''))),
+                               edited = $13,
+                               url = $14,
+                               version = version + 1
+							WHERE id = $1 AND ($15 = 0 OR version = $15)
+							RETURNING version, created, homeworld_id;`
+
+// importCharacter keeps the upstream identifier, a concurrent import of the same character is a no-op.
+var importCharacter = `INSERT INTO starwar.character (
+                               id,
+                               name,
                                height,
                                mass,
                                hair_color,
                                skin_color,
                                eye_color,
                                birth_year,
+                               birth_year_value,
                                gender,
                                homewor_ld,
+                               homeworld_id,
                                created,
                                edited,
-                               url
-							FROM starwar.character
-							WHERE id = $1;`
+                               url) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,
+                               (SELECT id FROM starwar.planet WHERE url = NULLIF($11, '')),
+                               COALESCE(NULLIF($12::timestamptz, '0001-01-01 00:00:00+00'), now()),
+                               COALESCE(NULLIF($13::timestamptz, '0001-01-01 00:00:00+00'), now()),
+                               $14)
+							ON CONFLICT (id) DO NOTHING;`
+
+// advanceCharacterSequence moves the id sequence past an imported identifier,
+// so characters created afterwards do not collide with it.
+var advanceCharacterSequence = `SELECT setval(pg_get_serial_sequence('starwar.character', 'id'),
+                               GREATEST($1::bigint, nextval(pg_get_serial_sequence('starwar.character', 'id'))));`
+
+var selectCharacterVersion = `SELECT version FROM starwar.character WHERE id = $1;`
 
 type StarwarRepositoryAdapter struct {
 	pool *pgxpool.Pool
@@ -51,24 +113,70 @@ func NewStarwarRepositoryAdapter(p *pgxpool.Pool) (*StarwarRepositoryAdapter, er
 	return &StarwarRepositoryAdapter{pool: p}, nil
 }
 
+func (a *StarwarRepositoryAdapter) querier(ctx context.Context) querier {
+	return querierFromContext(a.pool, ctx)
+}
+
+func (a *StarwarRepositoryAdapter) WithinTransaction(transactional func(ctx context.Context) error, ctx context.Context) error {
+
+	if _, ok := transactionFromContext(ctx); ok {
+		return transactional(ctx)
+	}
+
+	tx, err := a.pool.Begin(ctx)
+
+	if err != nil {
+		log.Printf("Error starting transaction %s\n", err.Error())
+		return pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error starting transaction %s\n", err.Error()),
+		}
+	}
+
+	defer tx.Rollback(ctx)
+
+	if err := transactional(contextWithTransaction(ctx, tx)); err != nil {
+		log.Printf("Rolling back transaction: %s\n", err.Error())
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("Error committing transaction %s\n", err.Error())
+		return pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error committing transaction %s\n", err.Error()),
+		}
+	}
+
+	return nil
+}
+
 func (a *StarwarRepositoryAdapter) CreateCharacter(character *model.Character, ctx context.Context) (*model.CharacterIdentifier, error) {
+	ctx = withOperation(ctx, "create_character")
+
 	insertResponse := repositoryModel.CharacterIdentifier{}
-	err := a.pool.QueryRow(ctx,
+	err := a.querier(ctx).QueryRow(ctx,
 		insertCharacter,
 		character.Name,
-		character.Height,
-		character.Mass,
+		character.Height.Value,
+		character.Mass.Value,
 		character.HairColor,
 		character.SkinColor,
 		character.EyeColor,
-		character.BirthYear,
+		character.BirthYear.String(),
+		character.BirthYear.Year,
 		character.Gender,
 		character.Homeworld,
+		character.HomeworldId,
 		character.Created,
 		character.Edited,
 		character.Url).
 		Scan(&insertResponse.Id)
 
+	if isForeignKeyViolation(err) {
+		return nil, unknownHomeworld(character.HomeworldId)
+	}
+
 	if err != nil {
 		log.Printf("Error creating a new character %s\n", err.Error())
 		return nil, &pkg.GenericException{
@@ -79,6 +187,10 @@ func (a *StarwarRepositoryAdapter) CreateCharacter(character *model.Character, c
 
 	log.Printf("New character Id: %d\n", insertResponse.Id)
 
+	if _, err := saveCharacterLinks(a.querier(ctx), insertResponse.Id, character, false, ctx); err != nil {
+		return nil, err
+	}
+
 	return &model.CharacterIdentifier{
 		Id: insertResponse.Id,
 	}, nil
@@ -86,10 +198,12 @@ func (a *StarwarRepositoryAdapter) CreateCharacter(character *model.Character, c
 
 func (a *StarwarRepositoryAdapter) FindCharacterById(character *model.CharacterIdentifier, ctx context.Context) (*model.CharacterDetail, error) {
 
+	ctx = withOperation(ctx, "find_character")
+
 	log.Printf("FindCharacterById: %d\n", character.Id)
 
 	findResponse := repositoryModel.CharacterRepository{}
-	err := a.pool.QueryRow(ctx, selectCharacter, character.Id).
+	err := a.querier(ctx).QueryRow(ctx, selectCharacter, character.Id).
 		Scan(
 			&findResponse.Name,
 			&findResponse.Height,
@@ -98,38 +212,316 @@ func (a *StarwarRepositoryAdapter) FindCharacterById(character *model.CharacterI
 			&findResponse.SkinColor,
 			&findResponse.EyeColor,
 			&findResponse.BirthYear,
+			&findResponse.BirthYearValue,
 			&findResponse.Gender,
 			&findResponse.Homeworld,
+			&findResponse.HomeworldId,
 			&findResponse.Created,
 			&findResponse.Edited,
 			&findResponse.Url,
+			&findResponse.Version,
 		)
 
 	if err != nil {
-		log.Printf("Error finding a new character: %s\n", err.Error())
+		return nil, findCharacterError(character, err)
+	}
+
+	log.Printf("Found character: %+v\n", findResponse)
+
+	findResponse.Id = character.Id
+
+	characterDetail := characterToDomain(&findResponse)
+
+	if err := findCharacterLinks(a.querier(ctx), []*model.CharacterDetail{characterDetail}, ctx); err != nil {
+		return nil, err
+	}
+
+	return characterDetail, nil
+}
+
+func (a *StarwarRepositoryAdapter) FindCharacters(query *model.CharacterQuery, ctx context.Context) (*model.CharacterPage, error) {
+
+	ctx = withOperation(ctx, "find_characters")
+
+	log.Printf("FindCharacters: %+v\n", query)
+
+	page := &model.CharacterPage{
+		Characters: []*model.CharacterDetail{},
+		Limit:      query.Limit,
+		Offset:     query.Offset,
+	}
+
+	countSql, countArgs := buildCharacterCountQuery(query)
+
+	if err := a.querier(ctx).QueryRow(ctx, countSql, countArgs...).Scan(&page.Total); err != nil {
+		log.Printf("Error counting characters: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error counting characters: %s\n", err.Error()),
+		}
+	}
+
+	sql, args := buildCharacterQuery(query)
+
+	rows, err := a.querier(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		log.Printf("Error finding characters: %s\n", err.Error())
 		return nil, pkg.GenericException{
-			StatusCode: http.StatusNotFound,
-			Msj:        fmt.Sprintf("Character Not Found: %s\n", err.Error()),
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error finding characters: %s\n", err.Error()),
 		}
 	}
+	defer rows.Close()
 
-	log.Printf("Found character: %+v\n", findResponse)
+	for rows.Next() {
+		findResponse, scanErr := scanProjectedCharacter(rows, query.Projection)
+
+		if scanErr != nil {
+			log.Printf("Error reading characters: %s\n", scanErr.Error())
+			return nil, pkg.GenericException{
+				StatusCode: http.StatusInternalServerError,
+				Msj:        fmt.Sprintf("Error reading characters: %s\n", scanErr.Error()),
+			}
+		}
+
+		page.Characters = append(page.Characters, characterToDomain(findResponse))
+	}
+
+	if rows.Err() != nil {
+		log.Printf("Error reading characters: %s\n", rows.Err().Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error reading characters: %s\n", rows.Err().Error()),
+		}
+	}
+
+	if query.Projection.IncludesLinks() {
+		if err := findCharacterLinks(a.querier(ctx), page.Characters, ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("Found %d characters of %d\n", len(page.Characters), page.Total)
 
+	return page, nil
+}
+
+func characterToDomain(c *repositoryModel.CharacterRepository) *model.CharacterDetail {
 	return &model.CharacterDetail{
-		Id: character,
+		Id: &model.CharacterIdentifier{Id: c.Id},
 		Character: &model.Character{
-			Name:      findResponse.Name,
-			Height:    findResponse.Height,
-			Mass:      findResponse.Mass,
-			HairColor: findResponse.HairColor,
-			SkinColor: findResponse.SkinColor,
-			EyeColor:  findResponse.EyeColor,
-			BirthYear: findResponse.BirthYear,
-			Gender:    findResponse.Gender,
-			Homeworld: findResponse.Homeworld,
-			Created:   findResponse.Created,
-			Edited:    findResponse.Edited,
-			Url:       findResponse.Url,
+			Name:        c.Name,
+			Height:      model.NewMeasure(c.Height, model.HeightUnit),
+			Mass:        model.NewMeasure(c.Mass, model.MassUnit),
+			HairColor:   c.HairColor,
+			SkinColor:   c.SkinColor,
+			EyeColor:    c.EyeColor,
+			BirthYear:   model.NewBirthYear(c.BirthYearValue, c.BirthYear),
+			Gender:      c.Gender,
+			Homeworld:   c.Homeworld,
+			HomeworldId: c.HomeworldId,
+			Created:     c.Created,
+			Edited:      c.Edited,
+			Url:         c.Url,
 		},
+		Version: c.Version,
+	}
+}
+
+func (a *StarwarRepositoryAdapter) UpdateCharacter(character *model.CharacterDetail, ctx context.Context) (*model.CharacterDetail, error) {
+
+	ctx = withOperation(ctx, "update_character")
+
+	log.Printf("UpdateCharacter: %d version: %d\n", character.Id.Id, character.Version)
+
+	var version int
+	var created time.Time
+	var homeworldId *int
+	err := a.querier(ctx).QueryRow(ctx,
+		updateCharacter,
+		character.Id.Id,
+		character.Character.Name,
+		character.Character.Height.Value,
+		character.Character.Mass.Value,
+		character.Character.HairColor,
+		character.Character.SkinColor,
+		character.Character.EyeColor,
+		character.Character.BirthYear.String(),
+		character.Character.BirthYear.Year,
+		character.Character.Gender,
+		character.Character.Homeworld,
+		character.Character.HomeworldId,
+		character.Character.Edited,
+		character.Character.Url,
+		character.Version).
+		Scan(&version, &created, &homeworldId)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, a.updateConflict(character, ctx)
+	}
+
+	if isForeignKeyViolation(err) {
+		return nil, unknownHomeworld(character.Character.HomeworldId)
+	}
+
+	if err != nil {
+		log.Printf("Error updating character %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error updating character %s\n", err.Error()),
+		}
+	}
+
+	links, err := saveCharacterLinks(a.querier(ctx), character.Id.Id, character.Character, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Updated character Id: %d new version: %d\n", character.Id.Id, version)
+
+	updatedCharacter := *character.Character
+	updatedCharacter.Created = created
+	updatedCharacter.HomeworldId = homeworldId
+	for kind, kindLinks := range links {
+		updatedCharacter.SetLinks(kind, kindLinks)
+	}
+
+	return &model.CharacterDetail{
+		Id:        character.Id,
+		Character: &updatedCharacter,
+		Version:   version,
 	}, nil
 }
+
+// ImportCharacter stores a character mirrored from an upstream source under its upstream identifier.
+// Links to resources that are not stored locally are dropped instead of rejecting the character.
+func (a *StarwarRepositoryAdapter) ImportCharacter(character *model.CharacterDetail, ctx context.Context) (*model.CharacterDetail, error) {
+
+	ctx = withOperation(ctx, "import_character")
+
+	log.Printf("ImportCharacter: %d\n", character.Id.Id)
+
+	commandTag, err := a.querier(ctx).Exec(ctx,
+		importCharacter,
+		character.Id.Id,
+		character.Character.Name,
+		character.Character.Height.Value,
+		character.Character.Mass.Value,
+		character.Character.HairColor,
+		character.Character.SkinColor,
+		character.Character.EyeColor,
+		character.Character.BirthYear.String(),
+		character.Character.BirthYear.Year,
+		character.Character.Gender,
+		character.Character.Homeworld,
+		character.Character.Created,
+		character.Character.Edited,
+		character.Character.Url)
+
+	if err != nil {
+		log.Printf("Error importing character %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error importing character %s\n", err.Error()),
+		}
+	}
+
+	if commandTag.RowsAffected() > 0 {
+		if _, err := saveCharacterLinks(a.querier(ctx), character.Id.Id, character.Character, true, ctx); err != nil {
+			return nil, err
+		}
+
+		if _, err := a.querier(ctx).Exec(ctx, advanceCharacterSequence, character.Id.Id); err != nil {
+			log.Printf("Error advancing character sequence %s\n", err.Error())
+			return nil, pkg.GenericException{
+				StatusCode: http.StatusInternalServerError,
+				Msj:        fmt.Sprintf("Error importing character %s\n", err.Error()),
+			}
+		}
+	}
+
+	return a.FindCharacterById(character.Id, ctx)
+}
+
+// findCharacterError only reports a missing row as not found, a failing database
+// must not look like an unknown character and trigger an import.
+func findCharacterError(character *model.CharacterIdentifier, err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return pkg.GenericException{
+			StatusCode: http.StatusNotFound,
+			Msj:        fmt.Sprintf("Character Not Found: %d\n", character.Id),
+		}
+	}
+
+	log.Printf("Error finding a character: %s\n", err.Error())
+	return pkg.GenericException{
+		StatusCode: http.StatusInternalServerError,
+		Msj:        fmt.Sprintf("Error finding a character: %s\n", err.Error()),
+	}
+}
+
+// updateConflict tells apart a missing character from one whose version has moved
+// when the compare and swap update did not match any row.
+func (a *StarwarRepositoryAdapter) updateConflict(character *model.CharacterDetail, ctx context.Context) error {
+
+	var currentVersion int
+	err := a.querier(ctx).QueryRow(ctx, selectCharacterVersion, character.Id.Id).Scan(&currentVersion)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return pkg.GenericException{
+			StatusCode: http.StatusNotFound,
+			Msj:        fmt.Sprintf("Character Not Found: %d\n", character.Id.Id),
+		}
+	}
+
+	if err != nil {
+		log.Printf("Error reading character version %s\n", err.Error())
+		return pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error updating character %s\n", err.Error()),
+		}
+	}
+
+	log.Printf("Character %d version mismatch, expected: %d current: %d\n", character.Id.Id, character.Version, currentVersion)
+
+	return pkg.GenericException{
+		StatusCode: http.StatusPreconditionFailed,
+		Msj:        fmt.Sprintf("Character %d was modified, current version: %d\n", character.Id.Id, currentVersion),
+	}
+}
+
+func (a *StarwarRepositoryAdapter) DatabaseStatistics(_ context.Context) (*model.DatabaseStatistics, error) {
+
+	stat := a.pool.Stat()
+
+	return &model.DatabaseStatistics{
+		AcquiredConns:        stat.AcquiredConns(),
+		IdleConns:            stat.IdleConns(),
+		ConstructingConns:    stat.ConstructingConns(),
+		TotalConns:           stat.TotalConns(),
+		MaxConns:             stat.MaxConns(),
+		AcquireCount:         stat.AcquireCount(),
+		AcquireDuration:      stat.AcquireDuration(),
+		EmptyAcquireCount:    stat.EmptyAcquireCount(),
+		CanceledAcquireCount: stat.CanceledAcquireCount(),
+		NewConnsCount:        stat.NewConnsCount(),
+	}, nil
+}
+
+// Ping acquires a connection of the pool and checks the database answers, the readiness probe calls it.
+func (a *StarwarRepositoryAdapter) Ping(ctx context.Context) error {
+	return a.pool.Ping(withOperation(ctx, "ping"))
+}
+
+func unknownHomeworld(homeworldId *int) error {
+	msj := "Homeworld planet does not exist\n"
+	if homeworldId != nil {
+		msj = fmt.Sprintf("Homeworld planet does not exist: %d\n", *homeworldId)
+	}
+
+	log.Print(msj)
+	return pkg.GenericException{
+		StatusCode: http.StatusUnprocessableEntity,
+		Msj:        msj,
+	}
+}
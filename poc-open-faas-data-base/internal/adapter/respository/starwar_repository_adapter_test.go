@@ -0,0 +1,35 @@
+package respository
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"testing"
+)
+
+func TestFindCharacterError(t *testing.T) {
+
+	type testCase struct {
+		name       string
+		err        error
+		statusCode int
+	}
+
+	testCases := []testCase{
+		{name: "missing character", err: pgx.ErrNoRows, statusCode: http.StatusNotFound},
+		{name: "wrapped missing character", err: fmt.Errorf("scan: %w", pgx.ErrNoRows), statusCode: http.StatusNotFound},
+		{name: "database error", err: errors.New("connection refused"), statusCode: http.StatusInternalServerError},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			statusCode, _ := pkg.GetErrorDetail(findCharacterError(&model.CharacterIdentifier{Id: 1}, test.err))
+
+			assert.Equal(t, test.statusCode, statusCode)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package respository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"handler/function/pkg"
+	"net/http"
+)
+
+type transactionKey struct{}
+
+// querier is the subset of pgxpool.Pool and pgx.Tx used by the repository adapters,
+// so the same query code runs either on the pool or inside the ambient transaction.
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// querierFromContext joins the ambient transaction if there is one, otherwise it uses the pool.
+func querierFromContext(pool *pgxpool.Pool, ctx context.Context) querier {
+	if tx, ok := transactionFromContext(ctx); ok {
+		return tx
+	}
+	return pool
+}
+
+func transactionFromContext(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(transactionKey{}).(pgx.Tx)
+	return tx, ok
+}
+
+func contextWithTransaction(ctx context.Context, tx pgx.Tx) context.Context {
+	return context.WithValue(ctx, transactionKey{}, tx)
+}
+
+const foreignKeyViolation = "23503"
+
+const uniqueViolation = "23505"
+
+func isForeignKeyViolation(err error) bool {
+	var pgError *pgconn.PgError
+	return errors.As(err, &pgError) && pgError.Code == foreignKeyViolation
+}
+
+func isUniqueViolation(err error) bool {
+	var pgError *pgconn.PgError
+	return errors.As(err, &pgError) && pgError.Code == uniqueViolation
+}
+
+// duplicatedUrl is the conflict of a url already used by another resource, resources without url never conflict.
+func duplicatedUrl(resource string, url string) error {
+	return pkg.GenericException{
+		StatusCode: http.StatusConflict,
+		Msj:        fmt.Sprintf("%s url already exists: %s\n", resource, url),
+	}
+}
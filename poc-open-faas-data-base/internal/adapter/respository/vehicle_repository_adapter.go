@@ -0,0 +1,215 @@
+package respository
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	repositoryModel "handler/function/internal/adapter/respository/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+)
+
+var _ out.LinkedResourceRepository[*model.Vehicle, *model.VehicleIdentifier, *model.VehicleDetail] = (*VehicleRepositoryAdapter)(nil)
+
+var insertVehicle = `INSERT INTO starwar.vehicle (
+                               name,
+                               model,
+                               manufacturer,
+                               cost_in_credits,
+                               length,
+                               max_atmosphering_speed,
+                               crew,
+                               passengers,
+                               cargo_capacity,
+                               consumables,
+                               vehicle_class,
+                               created,
+                               edited,
+                               url) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,NULLIF($14, '')) RETURNING Id;`
+
+var selectVehicleColumns = `SELECT  r.id,
+                               r.name,
+                               r.model,
+                               r.manufacturer,
+                               r.cost_in_credits,
+                               r.length,
+                               r.max_atmosphering_speed,
+                               r.crew,
+                               r.passengers,
+                               r.cargo_capacity,
+                               r.consumables,
+                               r.vehicle_class,
+                               r.created,
+                               r.edited,
+                               COALESCE(r.url, '')
+							FROM starwar.vehicle r`
+
+var selectVehicle = selectVehicleColumns + `
+							WHERE r.id = $1;`
+
+var selectVehiclesByCharacter = selectVehicleColumns + `
+							JOIN starwar.character_vehicle l ON l.vehicle_id = r.id
+							WHERE l.character_id = $1
+							ORDER BY r.id;`
+
+type VehicleRepositoryAdapter struct {
+	pool *pgxpool.Pool
+}
+
+func NewVehicleRepositoryAdapter(p *pgxpool.Pool) (*VehicleRepositoryAdapter, error) {
+	return &VehicleRepositoryAdapter{pool: p}, nil
+}
+
+func (a *VehicleRepositoryAdapter) querier(ctx context.Context) querier {
+	return querierFromContext(a.pool, ctx)
+}
+
+func (a *VehicleRepositoryAdapter) Create(vehicle *model.Vehicle, ctx context.Context) (*model.VehicleIdentifier, error) {
+	insertResponse := repositoryModel.VehicleRepository{}
+	err := a.querier(ctx).QueryRow(ctx,
+		insertVehicle,
+		vehicle.Name,
+		vehicle.Model,
+		vehicle.Manufacturer,
+		vehicle.CostInCredits,
+		vehicle.Length,
+		vehicle.MaxAtmospheringSpeed,
+		vehicle.Crew,
+		vehicle.Passengers,
+		vehicle.CargoCapacity,
+		vehicle.Consumables,
+		vehicle.VehicleClass,
+		vehicle.Created,
+		vehicle.Edited,
+		vehicle.Url).
+		Scan(&insertResponse.Id)
+
+	if isUniqueViolation(err) {
+		return nil, duplicatedUrl("Vehicle", vehicle.Url)
+	}
+
+	if err != nil {
+		log.Printf("Error creating a new vehicle %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error creating a new vehicle %s\n", err.Error()),
+		}
+	}
+
+	log.Printf("New vehicle Id: %d\n", insertResponse.Id)
+
+	return &model.VehicleIdentifier{
+		Id: insertResponse.Id,
+	}, nil
+}
+
+func (a *VehicleRepositoryAdapter) FindById(vehicle *model.VehicleIdentifier, ctx context.Context) (*model.VehicleDetail, error) {
+
+	log.Printf("FindVehicleById: %d\n", vehicle.Id)
+
+	findResponse, err := scanVehicle(a.querier(ctx).QueryRow(ctx, selectVehicle, vehicle.Id))
+
+	if err != nil {
+		log.Printf("Error finding a vehicle: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusNotFound,
+			Msj:        fmt.Sprintf("Vehicle Not Found: %s\n", err.Error()),
+		}
+	}
+
+	return vehicleToDomain(findResponse), nil
+}
+
+func (a *VehicleRepositoryAdapter) FindByCharacter(character *model.CharacterIdentifier, ctx context.Context) ([]*model.VehicleDetail, error) {
+
+	log.Printf("FindVehiclesByCharacter: %d\n", character.Id)
+
+	if err := characterExists(a.querier(ctx), character, ctx); err != nil {
+		return nil, err
+	}
+
+	rows, err := a.querier(ctx).Query(ctx, selectVehiclesByCharacter, character.Id)
+	if err != nil {
+		log.Printf("Error finding character vehicles: %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error finding character vehicles: %s\n", err.Error()),
+		}
+	}
+	defer rows.Close()
+
+	vehicleDetails := []*model.VehicleDetail{}
+
+	for rows.Next() {
+		findResponse, scanErr := scanVehicle(rows)
+
+		if scanErr != nil {
+			log.Printf("Error reading character vehicles: %s\n", scanErr.Error())
+			return nil, pkg.GenericException{
+				StatusCode: http.StatusInternalServerError,
+				Msj:        fmt.Sprintf("Error reading character vehicles: %s\n", scanErr.Error()),
+			}
+		}
+
+		vehicleDetails = append(vehicleDetails, vehicleToDomain(findResponse))
+	}
+
+	if rows.Err() != nil {
+		log.Printf("Error reading character vehicles: %s\n", rows.Err().Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error reading character vehicles: %s\n", rows.Err().Error()),
+		}
+	}
+
+	return vehicleDetails, nil
+}
+
+func scanVehicle(row pgx.Row) (*repositoryModel.VehicleRepository, error) {
+	findResponse := repositoryModel.VehicleRepository{}
+	err := row.Scan(
+		&findResponse.Id,
+		&findResponse.Name,
+		&findResponse.Model,
+		&findResponse.Manufacturer,
+		&findResponse.CostInCredits,
+		&findResponse.Length,
+		&findResponse.MaxAtmospheringSpeed,
+		&findResponse.Crew,
+		&findResponse.Passengers,
+		&findResponse.CargoCapacity,
+		&findResponse.Consumables,
+		&findResponse.VehicleClass,
+		&findResponse.Created,
+		&findResponse.Edited,
+		&findResponse.Url,
+	)
+
+	return &findResponse, err
+}
+
+func vehicleToDomain(r *repositoryModel.VehicleRepository) *model.VehicleDetail {
+	return &model.VehicleDetail{
+		Id: &model.VehicleIdentifier{Id: r.Id},
+		Vehicle: &model.Vehicle{
+			Name:                 r.Name,
+			Model:                r.Model,
+			Manufacturer:         r.Manufacturer,
+			CostInCredits:        r.CostInCredits,
+			Length:               r.Length,
+			MaxAtmospheringSpeed: r.MaxAtmospheringSpeed,
+			Crew:                 r.Crew,
+			Passengers:           r.Passengers,
+			CargoCapacity:        r.CargoCapacity,
+			Consumables:          r.Consumables,
+			VehicleClass:         r.VehicleClass,
+			Created:              r.Created,
+			Edited:               r.Edited,
+			Url:                  r.Url,
+		},
+	}
+}
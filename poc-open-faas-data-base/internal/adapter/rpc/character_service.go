@@ -0,0 +1,234 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"handler/function/internal/adapter/rpc/starwarpb"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+	"time"
+)
+
+var _ starwarpb.CharacterServiceServer = (*CharacterService)(nil)
+
+// CharacterService serves the character use cases over gRPC, the same ports the HTTP controllers call.
+type CharacterService struct {
+	starwarpb.UnimplementedCharacterServiceServer
+	createCharacter in.CreateCharacter
+	findCharacter   in.FindCharacter
+	listCharacters  in.ListCharacters
+}
+
+func NewCharacterService(
+	createCharacter in.CreateCharacter,
+	findCharacter in.FindCharacter,
+	listCharacters in.ListCharacters,
+) *CharacterService {
+	return &CharacterService{
+		createCharacter: createCharacter,
+		findCharacter:   findCharacter,
+		listCharacters:  listCharacters,
+	}
+}
+
+func (s *CharacterService) CreateCharacter(ctx context.Context, request *starwarpb.CreateCharacterRequest) (*starwarpb.CreateCharacterResponse, error) {
+	character, err := characterToDomain(request)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	identifier, err := s.createCharacter.CreateCharacter(character, ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &starwarpb.CreateCharacterResponse{Id: int64(identifier.Id)}, nil
+}
+
+func (s *CharacterService) FindCharacter(ctx context.Context, request *starwarpb.FindCharacterRequest) (*starwarpb.Character, error) {
+	if request.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid character identifier")
+	}
+
+	characterDetail, err := s.findCharacter.FindCharacter(&model.CharacterIdentifier{Id: int(request.Id)}, ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return characterFromDomain(characterDetail), nil
+}
+
+func (s *CharacterService) ListCharacters(ctx context.Context, request *starwarpb.ListCharactersRequest) (*starwarpb.ListCharactersResponse, error) {
+	if request.Limit < 0 || request.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	query := &model.CharacterQuery{
+		Limit:  int(request.Limit),
+		Offset: int(request.Offset),
+	}
+
+	if request.Filter != "" {
+		filter, err := model.ParseFilter(request.Filter)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid filter at %s", err.Error()))
+		}
+		query.Filter = filter
+	}
+
+	for _, sortOrder := range request.Sort {
+		if !model.CharacterSortFields[sortOrder.Field] {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid sort field: %s", sortOrder.Field))
+		}
+		query.Sort = append(query.Sort, model.SortOrder{Field: sortOrder.Field, Descending: sortOrder.Descending})
+	}
+
+	page, err := s.listCharacters.ListCharacters(query, ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	results := make([]*starwarpb.Character, 0, len(page.Characters))
+	for _, characterDetail := range page.Characters {
+		results = append(results, characterFromDomain(characterDetail))
+	}
+
+	return &starwarpb.ListCharactersResponse{
+		Count:   int32(page.Total),
+		Limit:   int32(page.Limit),
+		Offset:  int32(page.Offset),
+		Results: results,
+	}, nil
+}
+
+func characterToDomain(request *starwarpb.CreateCharacterRequest) (*model.Character, error) {
+	height, err := model.ParseHeight(request.Height)
+	if err != nil {
+		return nil, pkg.GenericException{StatusCode: http.StatusBadRequest, Msj: err.Error()}
+	}
+
+	mass, err := model.ParseMass(request.Mass)
+	if err != nil {
+		return nil, pkg.GenericException{StatusCode: http.StatusBadRequest, Msj: err.Error()}
+	}
+
+	birthYear, err := model.ParseBirthYear(request.BirthYear)
+	if err != nil {
+		return nil, pkg.GenericException{StatusCode: http.StatusBadRequest, Msj: err.Error()}
+	}
+
+	var homeworldId *int
+	if request.HomeworldId != nil {
+		id := int(*request.HomeworldId)
+		homeworldId = &id
+	}
+
+	return &model.Character{
+		Name:        request.Name,
+		Height:      height,
+		Mass:        mass,
+		HairColor:   request.HairColor,
+		SkinColor:   request.SkinColor,
+		EyeColor:    request.EyeColor,
+		BirthYear:   birthYear,
+		Gender:      request.Gender,
+		Homeworld:   request.Homeworld,
+		HomeworldId: homeworldId,
+		Url:         request.Url,
+		Films:       linksToDomain(request.Films),
+		Species:     linksToDomain(request.Species),
+		Vehicles:    linksToDomain(request.Vehicles),
+		Starships:   linksToDomain(request.Starships),
+	}, nil
+}
+
+func characterFromDomain(c *model.CharacterDetail) *starwarpb.Character {
+	var homeworldId *int64
+	if c.Character.HomeworldId != nil {
+		id := int64(*c.Character.HomeworldId)
+		homeworldId = &id
+	}
+
+	return &starwarpb.Character{
+		Id:          int64(c.Id.Id),
+		Name:        c.Character.Name,
+		Height:      c.Character.Height.String(),
+		Mass:        c.Character.Mass.String(),
+		HairColor:   c.Character.HairColor,
+		SkinColor:   c.Character.SkinColor,
+		EyeColor:    c.Character.EyeColor,
+		BirthYear:   c.Character.BirthYear.String(),
+		Gender:      c.Character.Gender,
+		Homeworld:   c.Character.Homeworld,
+		HomeworldId: homeworldId,
+		Films:       linksFromDomain(c.Character.Films),
+		Species:     linksFromDomain(c.Character.Species),
+		Vehicles:    linksFromDomain(c.Character.Vehicles),
+		Starships:   linksFromDomain(c.Character.Starships),
+		Created:     c.Character.Created.UTC().Format(time.RFC3339Nano),
+		Edited:      c.Character.Edited.UTC().Format(time.RFC3339Nano),
+		Url:         c.Character.Url,
+		Version:     int64(c.Version),
+	}
+}
+
+func linksToDomain(links []*starwarpb.Link) []model.ResourceLink {
+	if len(links) == 0 {
+		return nil
+	}
+
+	resourceLinks := make([]model.ResourceLink, 0, len(links))
+	for _, link := range links {
+		resourceLinks = append(resourceLinks, model.ResourceLink{Id: int(link.Id), Url: link.Url})
+	}
+
+	return resourceLinks
+}
+
+func linksFromDomain(resourceLinks []model.ResourceLink) []*starwarpb.Link {
+	links := make([]*starwarpb.Link, 0, len(resourceLinks))
+	for _, resourceLink := range resourceLinks {
+		links = append(links, &starwarpb.Link{Id: int64(resourceLink.Id), Url: resourceLink.Url})
+	}
+
+	return links
+}
+
+// httpCodes translates the status of the use case errors, anything else is an internal error.
+var httpCodes = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusUnprocessableEntity: codes.FailedPrecondition,
+	http.StatusPreconditionFailed:  codes.FailedPrecondition,
+	http.StatusBadGateway:          codes.Unavailable,
+	http.StatusServiceUnavailable:  codes.Unavailable,
+	http.StatusInternalServerError: codes.Internal,
+}
+
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	statusCode, errorMsj := pkg.GetErrorDetail(err)
+
+	code, ok := httpCodes[statusCode]
+	if !ok {
+		code = codes.Internal
+	}
+
+	if code == codes.Internal {
+		log.Printf("gRPC internal error: %s\n", errorMsj)
+	}
+
+	return status.Error(code, errorMsj)
+}
@@ -0,0 +1,217 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"handler/function/internal/adapter/rpc/starwarpb"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type CreateCharacterMock struct {
+	mock.Mock
+}
+
+func (c *CreateCharacterMock) CreateCharacter(character *model.Character, ctx context.Context) (*model.CharacterIdentifier, error) {
+	args := c.Called(character, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.CharacterIdentifier), nil
+}
+
+type FindCharacterMock struct {
+	mock.Mock
+}
+
+func (f *FindCharacterMock) FindCharacter(character *model.CharacterIdentifier, ctx context.Context) (*model.CharacterDetail, error) {
+	args := f.Called(character, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.CharacterDetail), nil
+}
+
+func (f *FindCharacterMock) FindCharacterProjection(character *model.CharacterIdentifier, projection *model.CharacterProjection, ctx context.Context) (*model.CharacterDetail, error) {
+	return f.FindCharacter(character, ctx)
+}
+
+type ListCharactersMock struct {
+	mock.Mock
+}
+
+func (l *ListCharactersMock) ListCharacters(query *model.CharacterQuery, ctx context.Context) (*model.CharacterPage, error) {
+	args := l.Called(query, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*model.CharacterPage), nil
+}
+
+var homeworldId = 1
+
+var characterHeight = 202.0
+
+var characterDetail = model.CharacterDetail{
+	Id: &model.CharacterIdentifier{Id: 4},
+	Character: &model.Character{
+		Name:        "Darth Vader",
+		Height:      model.Measure{Value: &characterHeight, Unit: model.HeightUnit},
+		HairColor:   "none",
+		Gender:      "male",
+		HomeworldId: &homeworldId,
+		Films:       []model.ResourceLink{{Id: 1, Url: "https://swapi.dev/api/films/1/"}},
+		Created:     time.Date(2014, 12, 10, 15, 18, 20, 704000000, time.UTC),
+		Edited:      time.Date(2014, 12, 20, 21, 17, 50, 313000000, time.UTC),
+	},
+	Version: 3,
+}
+
+func TestCharacterService_CreateCharacter(t *testing.T) {
+
+	createMock := CreateCharacterMock{}
+	createMock.On("CreateCharacter", mock.MatchedBy(func(c *model.Character) bool {
+		return c.Name == "Darth Vader" && c.Mass.Value != nil && *c.Mass.Value == 136 &&
+			*c.HomeworldId == 1 && len(c.Films) == 1 && c.Films[0].Id == 1
+	}), mock.Anything).Return(&model.CharacterIdentifier{Id: 4}, nil)
+
+	service := NewCharacterService(&createMock, &FindCharacterMock{}, &ListCharactersMock{})
+
+	id := int64(1)
+	response, err := service.CreateCharacter(context.Background(), &starwarpb.CreateCharacterRequest{
+		Name:        "Darth Vader",
+		Height:      "202",
+		Mass:        "136",
+		BirthYear:   "41.9BBY",
+		HomeworldId: &id,
+		Films:       []*starwarpb.Link{{Id: 1}},
+	})
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, 4, response.Id)
+}
+
+func TestCharacterService_CreateCharacterInvalidMass(t *testing.T) {
+
+	service := NewCharacterService(&CreateCharacterMock{}, &FindCharacterMock{}, &ListCharactersMock{})
+
+	_, err := service.CreateCharacter(context.Background(), &starwarpb.CreateCharacterRequest{Name: "Darth Vader", Mass: "heavy"})
+
+	assert.EqualValues(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCharacterService_FindCharacter(t *testing.T) {
+
+	findMock := FindCharacterMock{}
+	findMock.On("FindCharacter", &model.CharacterIdentifier{Id: 4}, mock.Anything).Return(&characterDetail, nil)
+
+	service := NewCharacterService(&CreateCharacterMock{}, &findMock, &ListCharactersMock{})
+
+	character, err := service.FindCharacter(context.Background(), &starwarpb.FindCharacterRequest{Id: 4})
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, "Darth Vader", character.Name)
+	assert.EqualValues(t, "202", character.Height)
+	assert.EqualValues(t, 1, *character.HomeworldId)
+	assert.EqualValues(t, "https://swapi.dev/api/films/1/", character.Films[0].Url)
+	assert.EqualValues(t, "2014-12-10T15:18:20.704Z", character.Created)
+	assert.EqualValues(t, 3, character.Version)
+}
+
+func TestCharacterService_FindCharacterErrors(t *testing.T) {
+
+	type testCase struct {
+		name string
+		err  error
+		code codes.Code
+	}
+
+	testCases := []testCase{
+		{name: "not found", err: pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Character not found"}, code: codes.NotFound},
+		{name: "bad request", err: pkg.GenericException{StatusCode: http.StatusBadRequest, Msj: "Invalid"}, code: codes.InvalidArgument},
+		{name: "upstream", err: pkg.GenericException{StatusCode: http.StatusBadGateway, Msj: "swapi down"}, code: codes.Unavailable},
+		{name: "internal", err: errors.New("connection refused"), code: codes.Internal},
+		{name: "deadline", err: context.DeadlineExceeded, code: codes.DeadlineExceeded},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			findMock := FindCharacterMock{}
+			findMock.On("FindCharacter", &model.CharacterIdentifier{Id: 4}, mock.Anything).Return(nil, test.err)
+
+			service := NewCharacterService(&CreateCharacterMock{}, &findMock, &ListCharactersMock{})
+
+			_, err := service.FindCharacter(context.Background(), &starwarpb.FindCharacterRequest{Id: 4})
+
+			assert.EqualValues(t, test.code, status.Code(err))
+		})
+	}
+}
+
+func TestCharacterService_FindCharacterInvalidId(t *testing.T) {
+
+	service := NewCharacterService(&CreateCharacterMock{}, &FindCharacterMock{}, &ListCharactersMock{})
+
+	_, err := service.FindCharacter(context.Background(), &starwarpb.FindCharacterRequest{})
+
+	assert.EqualValues(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCharacterService_ListCharacters(t *testing.T) {
+
+	listMock := ListCharactersMock{}
+	listMock.On("ListCharacters", mock.MatchedBy(func(q *model.CharacterQuery) bool {
+		return q.Limit == 5 && q.Offset == 10 && q.Filter != nil &&
+			len(q.Sort) == 1 && q.Sort[0].Field == "height" && q.Sort[0].Descending
+	}), mock.Anything).Return(&model.CharacterPage{Characters: []*model.CharacterDetail{&characterDetail}, Total: 11, Limit: 5, Offset: 10}, nil)
+
+	service := NewCharacterService(&CreateCharacterMock{}, &FindCharacterMock{}, &listMock)
+
+	response, err := service.ListCharacters(context.Background(), &starwarpb.ListCharactersRequest{
+		Filter: `gender eq "male"`,
+		Sort:   []*starwarpb.SortOrder{{Field: "height", Descending: true}},
+		Limit:  5,
+		Offset: 10,
+	})
+
+	assert.Nil(t, err)
+	assert.EqualValues(t, 11, response.Count)
+	assert.EqualValues(t, "Darth Vader", response.Results[0].Name)
+}
+
+func TestCharacterService_ListCharactersInvalidQuery(t *testing.T) {
+
+	type testCase struct {
+		name    string
+		request *starwarpb.ListCharactersRequest
+	}
+
+	testCases := []testCase{
+		{name: "filter", request: &starwarpb.ListCharactersRequest{Filter: `gender gt "male"`}},
+		{name: "sort", request: &starwarpb.ListCharactersRequest{Sort: []*starwarpb.SortOrder{{Field: "films"}}}},
+		{name: "offset", request: &starwarpb.ListCharactersRequest{Offset: -1}},
+	}
+
+	service := NewCharacterService(&CreateCharacterMock{}, &FindCharacterMock{}, &ListCharactersMock{})
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := service.ListCharacters(context.Background(), test.request)
+
+			assert.EqualValues(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"handler/function/internal/adapter/rpc/starwarpb"
+	"log"
+	"net"
+)
+
+// NewServer registers the services with reflection enabled, so tools like grpcurl can list them.
+func NewServer(characterService *CharacterService) *grpc.Server {
+	server := grpc.NewServer()
+	starwarpb.RegisterCharacterServiceServer(server, characterService)
+	reflection.Register(server)
+	return server
+}
+
+// Serve listens on the port in the background, the caller stops the server on shutdown.
+func Serve(server *grpc.Server, port int) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		log.Printf("gRPC server listening on %s\n", listener.Addr().String())
+		if serveErr := server.Serve(listener); serveErr != nil {
+			log.Printf("gRPC server error %s\n", serveErr.Error())
+		}
+	}()
+
+	return nil
+}
+
+// Drain stops the server once its calls in flight finish, the calls left when ctx is done are canceled.
+func Drain(server *grpc.Server, ctx context.Context) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.Stop()
+		<-stopped
+	}
+}
@@ -0,0 +1,120 @@
+package rpc
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"handler/function/internal/adapter/rpc/starwarpb"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func dialServer(t *testing.T, service *CharacterService) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := NewServer(service)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	connection, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	t.Cleanup(func() { connection.Close() })
+
+	return connection
+}
+
+func TestNewServer_FindCharacter(t *testing.T) {
+
+	findMock := FindCharacterMock{}
+	findMock.On("FindCharacter", &model.CharacterIdentifier{Id: 4}, mock.Anything).Return(&characterDetail, nil)
+	findMock.On("FindCharacter", &model.CharacterIdentifier{Id: 5}, mock.Anything).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Character not found"})
+
+	client := starwarpb.NewCharacterServiceClient(dialServer(t, NewCharacterService(&CreateCharacterMock{}, &findMock, &ListCharactersMock{})))
+
+	character, err := client.FindCharacter(context.Background(), &starwarpb.FindCharacterRequest{Id: 4})
+	assert.Nil(t, err)
+	assert.EqualValues(t, "Darth Vader", character.Name)
+
+	_, err = client.FindCharacter(context.Background(), &starwarpb.FindCharacterRequest{Id: 5})
+	assert.EqualValues(t, codes.NotFound, status.Code(err))
+	assert.EqualValues(t, "Character not found", status.Convert(err).Message())
+}
+
+func TestNewServer_Reflection(t *testing.T) {
+
+	client := reflectionpb.NewServerReflectionClient(dialServer(t, NewCharacterService(&CreateCharacterMock{}, &FindCharacterMock{}, &ListCharactersMock{})))
+
+	stream, err := client.ServerReflectionInfo(context.Background())
+	assert.Nil(t, err)
+
+	err = stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	})
+	assert.Nil(t, err)
+
+	response, err := stream.Recv()
+	assert.Nil(t, err)
+
+	services := []string{}
+	for _, service := range response.GetListServicesResponse().Service {
+		services = append(services, service.Name)
+	}
+
+	assert.Contains(t, services, "starwar.v1.CharacterService")
+}
+
+func TestDrain_CancelsCallsAfterTheGracePeriod(t *testing.T) {
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+
+	findMock := FindCharacterMock{}
+	findMock.On("FindCharacter", &model.CharacterIdentifier{Id: 4}, mock.Anything).
+		Run(func(args mock.Arguments) {
+			close(started)
+			<-release
+		}).
+		Return(&characterDetail, nil)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewServer(NewCharacterService(&CreateCharacterMock{}, &findMock, &ListCharactersMock{}))
+	go server.Serve(listener)
+
+	connection, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	defer connection.Close()
+
+	callErr := make(chan error, 1)
+	go func() {
+		_, err := starwarpb.NewCharacterServiceClient(connection).FindCharacter(context.Background(), &starwarpb.FindCharacterRequest{Id: 4})
+		callErr <- err
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	Drain(server, ctx)
+
+	assert.EqualValues(t, codes.Unavailable, status.Code(<-callErr))
+}
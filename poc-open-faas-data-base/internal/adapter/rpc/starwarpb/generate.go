@@ -0,0 +1,4 @@
+// Package starwarpb holds the protobuf definitions of the gRPC api and the code generated from them.
+package starwarpb
+
+//go:generate protoc -I ../../../.. --go_out=../../../.. --go_opt=paths=source_relative --go-grpc_out=../../../.. --go-grpc_opt=paths=source_relative internal/adapter/rpc/starwarpb/character_service.proto
@@ -0,0 +1,16 @@
+package model
+
+// Exporters of the spans, none keeps the tracing api as a no-op.
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOtlp   = "otlp"
+)
+
+type TracingOptions struct {
+	Exporter    string
+	ServiceName string
+	// OtlpEndpoint is the host and port of the collector, spans are sent over http.
+	OtlpEndpoint string
+	OtlpInsecure bool
+}
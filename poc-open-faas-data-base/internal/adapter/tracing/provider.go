@@ -0,0 +1,49 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	tracingModel "handler/function/internal/adapter/tracing/model"
+	"os"
+)
+
+// Propagator reads and writes the W3C traceparent and baggage headers.
+func Propagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+}
+
+// NewTracerProvider exports the spans with the configured exporter, the caller shuts it down to flush them.
+// A request that comes with a sampled traceparent is always traced.
+func NewTracerProvider(o *tracingModel.TracingOptions, ctx context.Context) (*sdktrace.TracerProvider, error) {
+	exporter, err := newExporter(o, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.AlwaysSample())),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(o.ServiceName))),
+	), nil
+}
+
+func newExporter(o *tracingModel.TracingOptions, ctx context.Context) (sdktrace.SpanExporter, error) {
+	switch o.Exporter {
+	case tracingModel.ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case tracingModel.ExporterOtlp:
+		options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(o.OtlpEndpoint)}
+		if o.OtlpInsecure {
+			options = append(options, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, options...)
+	default:
+		return nil, fmt.Errorf("unsupported exporter %q", o.Exporter)
+	}
+}
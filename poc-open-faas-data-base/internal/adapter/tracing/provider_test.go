@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	tracingModel "handler/function/internal/adapter/tracing/model"
+	"testing"
+)
+
+func TestNewTracerProvider(t *testing.T) {
+
+	type testCase struct {
+		name    string
+		options tracingModel.TracingOptions
+		fails   bool
+	}
+
+	testCases := []testCase{
+		{name: "stdout", options: tracingModel.TracingOptions{Exporter: tracingModel.ExporterStdout, ServiceName: "poc-open-faas-data-base"}},
+		{name: "otlp", options: tracingModel.TracingOptions{Exporter: tracingModel.ExporterOtlp, ServiceName: "poc-open-faas-data-base", OtlpEndpoint: "localhost:4318", OtlpInsecure: true}},
+		{name: "unknown exporter", options: tracingModel.TracingOptions{Exporter: "jaeger"}, fails: true},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			tracerProvider, err := NewTracerProvider(&test.options, context.Background())
+
+			if test.fails {
+				assert.NotNil(t, err)
+				assert.Nil(t, tracerProvider)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Nil(t, tracerProvider.Shutdown(context.Background()))
+		})
+	}
+}
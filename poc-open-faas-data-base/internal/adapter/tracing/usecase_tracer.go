@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"context"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/pkg"
+)
+
+var _ in.FindCharacter = (*TracedFindCharacter)(nil)
+var _ in.CreateCharacter = (*TracedCreateCharacter)(nil)
+
+var tracer = otel.Tracer("handler/function/internal/adapter/tracing")
+
+// TracedFindCharacter runs the use case in a span, the cache and repository spans are its children.
+type TracedFindCharacter struct {
+	findCharacter in.FindCharacter
+}
+
+func NewTracedFindCharacter(findCharacter in.FindCharacter) *TracedFindCharacter {
+	return &TracedFindCharacter{findCharacter: findCharacter}
+}
+
+func (t *TracedFindCharacter) FindCharacter(character *model.CharacterIdentifier, ctx context.Context) (*model.CharacterDetail, error) {
+	ctx, span := tracer.Start(ctx, "FindCharacter", trace.WithAttributes(attribute.Int("character.id", character.Id)))
+	defer span.End()
+
+	characterDetail, err := t.findCharacter.FindCharacter(character, ctx)
+	recordError(span, err)
+
+	return characterDetail, err
+}
+
+func (t *TracedFindCharacter) FindCharacterProjection(character *model.CharacterIdentifier, projection *model.CharacterProjection, ctx context.Context) (*model.CharacterDetail, error) {
+	ctx, span := tracer.Start(ctx, "FindCharacterProjection", trace.WithAttributes(
+		attribute.Int("character.id", character.Id),
+		attribute.Bool("character.projected", projection != nil)))
+	defer span.End()
+
+	characterDetail, err := t.findCharacter.FindCharacterProjection(character, projection, ctx)
+	recordError(span, err)
+
+	return characterDetail, err
+}
+
+type TracedCreateCharacter struct {
+	createCharacter in.CreateCharacter
+}
+
+func NewTracedCreateCharacter(createCharacter in.CreateCharacter) *TracedCreateCharacter {
+	return &TracedCreateCharacter{createCharacter: createCharacter}
+}
+
+func (t *TracedCreateCharacter) CreateCharacter(character *model.Character, ctx context.Context) (*model.CharacterIdentifier, error) {
+	ctx, span := tracer.Start(ctx, "CreateCharacter")
+	defer span.End()
+
+	characterIdentifier, err := t.createCharacter.CreateCharacter(character, ctx)
+	if characterIdentifier != nil {
+		span.SetAttributes(attribute.Int("character.id", characterIdentifier.Id))
+	}
+	recordError(span, err)
+
+	return characterIdentifier, err
+}
+
+// recordError marks the span failed, client errors such as a character not found are only recorded.
+func recordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+
+	if statusCode, msj := pkg.GetErrorDetail(err); statusCode >= 500 {
+		span.SetStatus(codes.Error, msj)
+	}
+}
@@ -0,0 +1,161 @@
+package tracing
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"testing"
+)
+
+var spanExporter = tracetest.NewInMemoryExporter()
+
+func init() {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(spanExporter)))
+}
+
+type FindCharacterUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *FindCharacterUseCaseMock) FindCharacter(character *model.CharacterIdentifier, ctx context.Context) (*model.CharacterDetail, error) {
+	args := m.Called(character, ctx)
+	characterDetail, _ := args.Get(0).(*model.CharacterDetail)
+	return characterDetail, args.Error(1)
+}
+
+func (m *FindCharacterUseCaseMock) FindCharacterProjection(character *model.CharacterIdentifier, projection *model.CharacterProjection, ctx context.Context) (*model.CharacterDetail, error) {
+	args := m.Called(character, projection, ctx)
+	characterDetail, _ := args.Get(0).(*model.CharacterDetail)
+	return characterDetail, args.Error(1)
+}
+
+type CreateCharacterUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *CreateCharacterUseCaseMock) CreateCharacter(character *model.Character, ctx context.Context) (*model.CharacterIdentifier, error) {
+	args := m.Called(character, ctx)
+	characterIdentifier, _ := args.Get(0).(*model.CharacterIdentifier)
+	return characterIdentifier, args.Error(1)
+}
+
+// inSpan matches the ctx of the span started by the decorator, the spans of the use case are its children.
+func inSpan() interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		return trace.SpanContextFromContext(ctx).IsValid()
+	})
+}
+
+func TestTracedFindCharacter(t *testing.T) {
+
+	type testCase struct {
+		name            string
+		characterDetail *model.CharacterDetail
+		err             error
+		status          codes.Code
+		events          int
+	}
+
+	characterIdentifier := &model.CharacterIdentifier{Id: 1}
+
+	testCases := []testCase{
+		{name: "found", characterDetail: &model.CharacterDetail{Id: characterIdentifier}, status: codes.Unset},
+		{name: "not found", err: pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "character not found"}, status: codes.Unset, events: 1},
+		{name: "failed", err: pkg.GenericException{StatusCode: http.StatusInternalServerError, Msj: "database error"}, status: codes.Error, events: 1},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			spanExporter.Reset()
+
+			findCharacter := FindCharacterUseCaseMock{}
+			findCharacter.On("FindCharacter", characterIdentifier, inSpan()).Return(test.characterDetail, test.err)
+
+			characterDetail, err := NewTracedFindCharacter(&findCharacter).FindCharacter(characterIdentifier, context.Background())
+
+			assert.Equal(t, test.characterDetail, characterDetail)
+			assert.Equal(t, test.err, err)
+			findCharacter.AssertExpectations(t)
+
+			spans := spanExporter.GetSpans()
+			if assert.Len(t, spans, 1) {
+				assert.Equal(t, "FindCharacter", spans[0].Name)
+				assert.Equal(t, test.status, spans[0].Status.Code)
+				assert.Len(t, spans[0].Events, test.events)
+				assert.Contains(t, spans[0].Attributes, attribute.Int("character.id", 1))
+			}
+		})
+	}
+}
+
+func TestTracedFindCharacter_FindCharacterProjection(t *testing.T) {
+	spanExporter.Reset()
+
+	characterIdentifier := &model.CharacterIdentifier{Id: 1}
+	projection := &model.CharacterProjection{}
+	characterDetail := &model.CharacterDetail{Id: characterIdentifier}
+
+	findCharacter := FindCharacterUseCaseMock{}
+	findCharacter.On("FindCharacterProjection", characterIdentifier, projection, inSpan()).Return(characterDetail, nil)
+
+	result, err := NewTracedFindCharacter(&findCharacter).FindCharacterProjection(characterIdentifier, projection, context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, characterDetail, result)
+
+	spans := spanExporter.GetSpans()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "FindCharacterProjection", spans[0].Name)
+		assert.Contains(t, spans[0].Attributes, attribute.Bool("character.projected", true))
+	}
+}
+
+func TestTracedCreateCharacter(t *testing.T) {
+
+	type testCase struct {
+		name                string
+		characterIdentifier *model.CharacterIdentifier
+		err                 error
+		status              codes.Code
+	}
+
+	testCases := []testCase{
+		{name: "created", characterIdentifier: &model.CharacterIdentifier{Id: 7}, status: codes.Unset},
+		{name: "invalid", err: pkg.GenericException{StatusCode: http.StatusBadRequest, Msj: "invalid character"}, status: codes.Unset},
+		{name: "failed", err: pkg.GenericException{StatusCode: http.StatusInternalServerError, Msj: "database error"}, status: codes.Error},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			spanExporter.Reset()
+
+			character := &model.Character{Name: "Darth Ezequiel"}
+
+			createCharacter := CreateCharacterUseCaseMock{}
+			createCharacter.On("CreateCharacter", character, inSpan()).Return(test.characterIdentifier, test.err)
+
+			characterIdentifier, err := NewTracedCreateCharacter(&createCharacter).CreateCharacter(character, context.Background())
+
+			assert.Equal(t, test.characterIdentifier, characterIdentifier)
+			assert.Equal(t, test.err, err)
+
+			spans := spanExporter.GetSpans()
+			if assert.Len(t, spans, 1) {
+				assert.Equal(t, "CreateCharacter", spans[0].Name)
+				assert.Equal(t, test.status, spans[0].Status.Code)
+				if test.characterIdentifier != nil {
+					assert.Contains(t, spans[0].Attributes, attribute.Int("character.id", test.characterIdentifier.Id))
+				}
+			}
+		})
+	}
+}
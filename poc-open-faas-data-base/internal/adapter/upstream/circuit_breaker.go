@@ -0,0 +1,60 @@
+package upstream
+
+import (
+	"sync"
+	"time"
+)
+
+// circuitBreaker stops calling the upstream after failureThreshold consecutive failures.
+// Once openTimeout has elapsed a single trial call is let through, its result closes
+// the circuit again or keeps it open for another openTimeout.
+type circuitBreaker struct {
+	mutex            sync.Mutex
+	failureThreshold int
+	openTimeout      time.Duration
+	failures         int
+	openedAt         time.Time
+	now              func() time.Time
+}
+
+func newCircuitBreaker(failureThreshold int, openTimeout time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+		now:              time.Now,
+	}
+}
+
+func (b *circuitBreaker) allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.failureThreshold <= 0 || b.failures < b.failureThreshold {
+		return true
+	}
+
+	if b.now().Sub(b.openedAt) < b.openTimeout {
+		return false
+	}
+
+	// half open, the trial call holds the circuit open for the rest of the callers.
+	b.openedAt = b.now()
+	return true
+}
+
+func (b *circuitBreaker) success() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.failures = 0
+}
+
+func (b *circuitBreaker) failure() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.failures++
+	if b.failureThreshold > 0 && b.failures >= b.failureThreshold {
+		b.openedAt = b.now()
+	}
+}
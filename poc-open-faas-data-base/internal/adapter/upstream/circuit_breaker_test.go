@@ -0,0 +1,44 @@
+package upstream
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2023, 4, 1, 10, 30, 0, 0, time.UTC)
+
+	breaker := newCircuitBreaker(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	assert.True(t, breaker.allow())
+	breaker.failure()
+	assert.True(t, breaker.allow(), "below the threshold the circuit stays closed")
+
+	breaker.failure()
+	assert.False(t, breaker.allow(), "the threshold opens the circuit")
+
+	now = now.Add(time.Minute)
+	assert.True(t, breaker.allow(), "after the open timeout a trial call is allowed")
+	assert.False(t, breaker.allow(), "only one trial call at a time")
+
+	breaker.failure()
+	assert.False(t, breaker.allow(), "a failed trial keeps the circuit open")
+
+	now = now.Add(time.Minute)
+	assert.True(t, breaker.allow())
+	breaker.success()
+	assert.True(t, breaker.allow(), "a successful trial closes the circuit")
+	assert.True(t, breaker.allow())
+}
+
+func TestCircuitBreakerSuccessResetsFailures(t *testing.T) {
+	breaker := newCircuitBreaker(2, time.Minute)
+
+	breaker.failure()
+	breaker.success()
+	breaker.failure()
+
+	assert.True(t, breaker.allow())
+}
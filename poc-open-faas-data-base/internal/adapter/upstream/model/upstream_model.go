@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+type SourceOptions struct {
+	BaseUrl          string
+	Timeout          time.Duration
+	FailureThreshold int
+	OpenTimeout      time.Duration
+}
+
+// SwapiPeople is the people resource as served by swapi.dev.
+type SwapiPeople struct {
+	Name      string   `json:"name"`
+	Height    string   `json:"height"`
+	Mass      string   `json:"mass"`
+	HairColor string   `json:"hair_color"`
+	SkinColor string   `json:"skin_color"`
+	EyeColor  string   `json:"eye_color"`
+	BirthYear string   `json:"birth_year"`
+	Gender    string   `json:"gender"`
+	Homeworld string   `json:"homeworld"`
+	Films     []string `json:"films"`
+	Species   []string `json:"species"`
+	Vehicles  []string `json:"vehicles"`
+	Starships []string `json:"starships"`
+	Created   string   `json:"created"`
+	Edited    string   `json:"edited"`
+	Url       string   `json:"url"`
+}
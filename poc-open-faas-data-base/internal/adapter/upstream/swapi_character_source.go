@@ -0,0 +1,212 @@
+package upstream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	upstreamModel "handler/function/internal/adapter/upstream/model"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var _ out.CharacterSource = (*SwapiCharacterSource)(nil)
+
+const (
+	defaultTimeout          = 2 * time.Second
+	defaultFailureThreshold = 5
+	defaultOpenTimeout      = 30 * time.Second
+)
+
+// SwapiCharacterSource reads characters from a server speaking the swapi.dev people API.
+type SwapiCharacterSource struct {
+	client  *http.Client
+	baseUrl string
+	timeout time.Duration
+	breaker *circuitBreaker
+}
+
+func NewSwapiCharacterSource(c *http.Client, o *upstreamModel.SourceOptions) (*SwapiCharacterSource, error) {
+	if o.BaseUrl == "" {
+		return nil, fmt.Errorf("character source base url is required")
+	}
+
+	timeout := o.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	failureThreshold := o.FailureThreshold
+	if failureThreshold <= 0 {
+		failureThreshold = defaultFailureThreshold
+	}
+
+	openTimeout := o.OpenTimeout
+	if openTimeout <= 0 {
+		openTimeout = defaultOpenTimeout
+	}
+
+	return &SwapiCharacterSource{
+		client:  c,
+		baseUrl: strings.TrimSuffix(o.BaseUrl, "/"),
+		timeout: timeout,
+		breaker: newCircuitBreaker(failureThreshold, openTimeout),
+	}, nil
+}
+
+func (s *SwapiCharacterSource) FindCharacterById(character *model.CharacterIdentifier, ctx context.Context) (*model.Character, error) {
+
+	if !s.breaker.allow() {
+		log.Printf("Character source circuit open, skipping character %d\n", character.Id)
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusServiceUnavailable,
+			Msj:        "Character source unavailable\n",
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	url := fmt.Sprintf("%s/people/%d/", s.baseUrl, character.Id)
+	log.Printf("Searching character in source: %s\n", url)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusInternalServerError,
+			Msj:        fmt.Sprintf("Error building character source request %s\n", err.Error()),
+		}
+	}
+	request.Header.Set("Accept", "application/json")
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		s.breaker.failure()
+		log.Printf("Error calling character source %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusBadGateway,
+			Msj:        fmt.Sprintf("Error calling character source %s\n", err.Error()),
+		}
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == http.StatusNotFound:
+		s.breaker.success()
+		return nil, nil
+
+	case response.StatusCode >= http.StatusInternalServerError:
+		s.breaker.failure()
+		log.Printf("Character source failed with status %d\n", response.StatusCode)
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusBadGateway,
+			Msj:        fmt.Sprintf("Character source failed with status %d\n", response.StatusCode),
+		}
+
+	case response.StatusCode != http.StatusOK:
+		s.breaker.success()
+		log.Printf("Unexpected character source status %d\n", response.StatusCode)
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusBadGateway,
+			Msj:        fmt.Sprintf("Unexpected character source status %d\n", response.StatusCode),
+		}
+	}
+
+	people := &upstreamModel.SwapiPeople{}
+	if err := json.NewDecoder(response.Body).Decode(people); err != nil {
+		s.breaker.failure()
+		log.Printf("Error reading character source response %s\n", err.Error())
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusBadGateway,
+			Msj:        fmt.Sprintf("Error reading character source response %s\n", err.Error()),
+		}
+	}
+
+	s.breaker.success()
+
+	return peopleToDomain(people)
+}
+
+func peopleToDomain(p *upstreamModel.SwapiPeople) (*model.Character, error) {
+	height, err := model.ParseHeight(p.Height)
+	if err != nil {
+		return nil, invalidPeople(err)
+	}
+
+	mass, err := model.ParseMass(p.Mass)
+	if err != nil {
+		return nil, invalidPeople(err)
+	}
+
+	birthYear, err := model.ParseBirthYear(p.BirthYear)
+	if err != nil {
+		return nil, invalidPeople(err)
+	}
+
+	created, err := parseSwapiTimestamp(p.Created)
+	if err != nil {
+		return nil, invalidPeople(err)
+	}
+
+	edited, err := parseSwapiTimestamp(p.Edited)
+	if err != nil {
+		return nil, invalidPeople(err)
+	}
+
+	return &model.Character{
+		Name:      p.Name,
+		Height:    height,
+		Mass:      mass,
+		HairColor: p.HairColor,
+		SkinColor: p.SkinColor,
+		EyeColor:  p.EyeColor,
+		BirthYear: birthYear,
+		Gender:    p.Gender,
+		Homeworld: p.Homeworld,
+		Films:     urlsToLinks(p.Films),
+		Species:   urlsToLinks(p.Species),
+		Vehicles:  urlsToLinks(p.Vehicles),
+		Starships: urlsToLinks(p.Starships),
+		Created:   created,
+		Edited:    edited,
+		Url:       p.Url,
+	}, nil
+}
+
+func parseSwapiTimestamp(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return timestamp.UTC(), nil
+}
+
+func urlsToLinks(urls []string) []model.ResourceLink {
+	if len(urls) == 0 {
+		return nil
+	}
+
+	links := make([]model.ResourceLink, 0, len(urls))
+	for _, url := range urls {
+		links = append(links, model.ResourceLink{Url: url})
+	}
+
+	return links
+}
+
+func invalidPeople(err error) error {
+	log.Printf("Invalid character from source %s\n", err.Error())
+	return pkg.GenericException{
+		StatusCode: http.StatusBadGateway,
+		Msj:        fmt.Sprintf("Invalid character from source %s\n", err.Error()),
+	}
+}
@@ -0,0 +1,163 @@
+package upstream
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	upstreamModel "handler/function/internal/adapter/upstream/model"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var swapiLuke = `{
+	"name": "Luke Skywalker",
+	"height": "172",
+	"mass": "77",
+	"hair_color": "blond",
+	"skin_color": "fair",
+	"eye_color": "blue",
+	"birth_year": "19BBY",
+	"gender": "male",
+	"homeworld": "https://swapi.dev/api/planets/1/",
+	"films": ["https://swapi.dev/api/films/1/", "https://swapi.dev/api/films/2/"],
+	"species": [],
+	"vehicles": ["https://swapi.dev/api/vehicles/14/"],
+	"starships": ["https://swapi.dev/api/starships/12/"],
+	"created": "2014-12-09T13:50:51.644000Z",
+	"edited": "2014-12-20T21:17:56.891000Z",
+	"url": "https://swapi.dev/api/people/1/"
+}`
+
+// newFakeSwapi stands in for swapi.dev, only /people/1/ exists.
+func newFakeSwapi(status *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if code := atomic.LoadInt32(status); code != http.StatusOK {
+			w.WriteHeader(int(code))
+			return
+		}
+
+		if r.URL.Path != "/api/people/1/" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"detail": "Not found"}`)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, swapiLuke)
+	}))
+}
+
+func newTestSource(t *testing.T, server *httptest.Server, failureThreshold int) *SwapiCharacterSource {
+	source, err := NewSwapiCharacterSource(server.Client(), &upstreamModel.SourceOptions{
+		BaseUrl:          server.URL + "/api/",
+		Timeout:          time.Second,
+		FailureThreshold: failureThreshold,
+		OpenTimeout:      time.Minute,
+	})
+	assert.Nil(t, err)
+	return source
+}
+
+func TestNewSwapiCharacterSourceWithoutUrl(t *testing.T) {
+	source, err := NewSwapiCharacterSource(http.DefaultClient, &upstreamModel.SourceOptions{})
+
+	assert.Nil(t, source)
+	assert.NotNil(t, err)
+}
+
+func TestSwapiCharacterSource_FindCharacterById(t *testing.T) {
+	status := int32(http.StatusOK)
+	server := newFakeSwapi(&status)
+	defer server.Close()
+
+	source := newTestSource(t, server, 2)
+
+	character, err := source.FindCharacterById(&model.CharacterIdentifier{Id: 1}, context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Luke Skywalker", character.Name)
+	assert.Equal(t, 172.0, *character.Height.Value)
+	assert.Equal(t, "19BBY", character.BirthYear.String())
+	assert.Equal(t, "https://swapi.dev/api/planets/1/", character.Homeworld)
+	assert.Equal(t, []model.ResourceLink{{Url: "https://swapi.dev/api/films/1/"}, {Url: "https://swapi.dev/api/films/2/"}}, character.Films)
+	assert.Nil(t, character.Species)
+	assert.Equal(t, time.Date(2014, 12, 9, 13, 50, 51, 644000000, time.UTC), character.Created)
+	assert.Equal(t, "https://swapi.dev/api/people/1/", character.Url)
+}
+
+func TestSwapiCharacterSource_FindCharacterByIdNotFound(t *testing.T) {
+	status := int32(http.StatusOK)
+	server := newFakeSwapi(&status)
+	defer server.Close()
+
+	source := newTestSource(t, server, 2)
+
+	character, err := source.FindCharacterById(&model.CharacterIdentifier{Id: 99}, context.Background())
+
+	assert.Nil(t, err)
+	assert.Nil(t, character)
+}
+
+func TestSwapiCharacterSource_FindCharacterByIdTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	source, _ := NewSwapiCharacterSource(server.Client(), &upstreamModel.SourceOptions{
+		BaseUrl: server.URL + "/api",
+		Timeout: 10 * time.Millisecond,
+	})
+
+	character, err := source.FindCharacterById(&model.CharacterIdentifier{Id: 1}, context.Background())
+
+	statusCode, _ := pkg.GetErrorDetail(err)
+	assert.Nil(t, character)
+	assert.Equal(t, http.StatusBadGateway, statusCode)
+}
+
+func TestSwapiCharacterSource_FindCharacterByIdCircuitOpen(t *testing.T) {
+	status := int32(http.StatusInternalServerError)
+	server := newFakeSwapi(&status)
+	defer server.Close()
+
+	source := newTestSource(t, server, 2)
+	identifier := &model.CharacterIdentifier{Id: 1}
+
+	for i := 0; i < 2; i++ {
+		_, err := source.FindCharacterById(identifier, context.Background())
+		statusCode, _ := pkg.GetErrorDetail(err)
+		assert.Equal(t, http.StatusBadGateway, statusCode)
+	}
+
+	atomic.StoreInt32(&status, http.StatusOK)
+
+	character, err := source.FindCharacterById(identifier, context.Background())
+
+	statusCode, _ := pkg.GetErrorDetail(err)
+	assert.Nil(t, character)
+	assert.Equal(t, http.StatusServiceUnavailable, statusCode, "the open circuit does not reach the upstream")
+}
+
+func TestSwapiCharacterSource_FindCharacterByIdInvalidPeople(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name": "Luke Skywalker", "height": "tall"}`)
+	}))
+	defer server.Close()
+
+	source, _ := NewSwapiCharacterSource(server.Client(), &upstreamModel.SourceOptions{BaseUrl: server.URL})
+
+	character, err := source.FindCharacterById(&model.CharacterIdentifier{Id: 1}, context.Background())
+
+	statusCode, _ := pkg.GetErrorDetail(err)
+	assert.Nil(t, character)
+	assert.Equal(t, http.StatusBadGateway, statusCode)
+}
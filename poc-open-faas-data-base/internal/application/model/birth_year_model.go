@@ -0,0 +1,70 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	BeforeBattleOfYavin = "BBY"
+	AfterBattleOfYavin  = "ABY"
+)
+
+var birthYearPattern = regexp.MustCompile(`^(?i)([0-9]+(?:\.[0-9]+)?)\s*(BBY|ABY)$`)
+
+// BirthYear keeps the SWAPI text (e.g. "41.9BBY") and the year relative to the Battle of Yavin,
+// negative before it, so birth years can be compared and sorted. A nil Year means "unknown".
+type BirthYear struct {
+	Year *float64
+	Text string
+}
+
+func NewBirthYear(year *float64, text string) BirthYear {
+	return BirthYear{Year: year, Text: text}
+}
+
+func ParseBirthYear(text string) (BirthYear, error) {
+	trimmed := strings.TrimSpace(text)
+
+	if trimmed == "" || strings.EqualFold(trimmed, unknown) {
+		return BirthYear{Text: unknown}, nil
+	}
+
+	match := birthYearPattern.FindStringSubmatch(trimmed)
+	if match == nil {
+		return BirthYear{}, fmt.Errorf("invalid birth_year, expected a year followed by BBY or ABY: %s", text)
+	}
+
+	year, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return BirthYear{}, fmt.Errorf("invalid birth_year: %s", text)
+	}
+
+	if strings.EqualFold(match[2], BeforeBattleOfYavin) && year != 0 {
+		year = -year
+	}
+
+	return BirthYear{Year: &year, Text: match[1] + strings.ToUpper(match[2])}, nil
+}
+
+func (b BirthYear) Known() bool {
+	return b.Year != nil
+}
+
+func (b BirthYear) String() string {
+	if b.Text != "" {
+		return b.Text
+	}
+
+	if b.Year == nil {
+		return unknown
+	}
+
+	if *b.Year < 0 {
+		return strconv.FormatFloat(-*b.Year, 'f', -1, 64) + BeforeBattleOfYavin
+	}
+
+	return strconv.FormatFloat(*b.Year, 'f', -1, 64) + AfterBattleOfYavin
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseBirthYear(t *testing.T) {
+
+	testCase := []struct {
+		testName string
+		text     string
+		year     float64
+		known    bool
+		render   string
+	}{
+		{testName: "before yavin", text: "41.9BBY", year: -41.9, known: true, render: "41.9BBY"},
+		{testName: "after yavin", text: "4ABY", year: 4, known: true, render: "4ABY"},
+		{testName: "battle of yavin", text: "0BBY", year: 0, known: true, render: "0BBY"},
+		{testName: "lower case with space", text: "19 bby", year: -19, known: true, render: "19BBY"},
+		{testName: "unknown", text: "unknown", known: false, render: "unknown"},
+		{testName: "empty", text: "", known: false, render: "unknown"},
+	}
+
+	for _, param := range testCase {
+		t.Run(param.testName, func(t *testing.T) {
+			birthYear, err := ParseBirthYear(param.text)
+
+			assert.Nil(t, err)
+			assert.Equal(t, param.known, birthYear.Known())
+			assert.Equal(t, param.render, birthYear.String())
+			if param.known {
+				assert.Equal(t, param.year, *birthYear.Year)
+			}
+		})
+	}
+}
+
+func TestParseBirthYearError(t *testing.T) {
+
+	for _, text := range []string{"41.9", "BBY", "-10BBY", "ten ABY", "1990"} {
+		t.Run(text, func(t *testing.T) {
+			_, err := ParseBirthYear(text)
+
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestBirthYearString(t *testing.T) {
+
+	before := -896.0
+	after := 12.5
+
+	assert.Equal(t, "896BBY", NewBirthYear(&before, "").String())
+	assert.Equal(t, "12.5ABY", NewBirthYear(&after, "").String())
+	assert.Equal(t, "unknown", NewBirthYear(nil, "").String())
+}
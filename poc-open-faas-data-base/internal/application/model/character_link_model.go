@@ -0,0 +1,57 @@
+package model
+
+// LinkKind names a many to many relation between characters and another SWAPI resource.
+type LinkKind string
+
+const (
+	LinkFilms     LinkKind = "films"
+	LinkSpecies   LinkKind = "species"
+	LinkVehicles  LinkKind = "vehicles"
+	LinkStarships LinkKind = "starships"
+)
+
+// LinkKinds keeps the relations in the order SWAPI lists them.
+var LinkKinds = []LinkKind{LinkFilms, LinkSpecies, LinkVehicles, LinkStarships}
+
+// ResourceLink points to a related resource by id, by SWAPI url or by both once it is resolved.
+type ResourceLink struct {
+	Id  int
+	Url string
+}
+
+// Links returns the character links of the given kind.
+func (c *Character) Links(kind LinkKind) []ResourceLink {
+	switch kind {
+	case LinkFilms:
+		return c.Films
+	case LinkSpecies:
+		return c.Species
+	case LinkVehicles:
+		return c.Vehicles
+	case LinkStarships:
+		return c.Starships
+	}
+	return nil
+}
+
+// SetLinks replaces the character links of the given kind.
+func (c *Character) SetLinks(kind LinkKind, links []ResourceLink) {
+	switch kind {
+	case LinkFilms:
+		c.Films = links
+	case LinkSpecies:
+		c.Species = links
+	case LinkVehicles:
+		c.Vehicles = links
+	case LinkStarships:
+		c.Starships = links
+	}
+}
+
+// CharacterLink selects a page of the characters related to the resource Id of the given kind.
+type CharacterLink struct {
+	Kind   LinkKind
+	Id     int
+	Limit  int
+	Offset int
+}
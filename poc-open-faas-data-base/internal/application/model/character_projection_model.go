@@ -0,0 +1,66 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CharacterFields are the fields a character response can be narrowed to with ?fields=.
+var CharacterFields = map[string]bool{
+	"id":           true,
+	"name":         true,
+	"height":       true,
+	"mass":         true,
+	"hair_color":   true,
+	"skin_color":   true,
+	"eye_color":    true,
+	"birth_year":   true,
+	"gender":       true,
+	"homeworld":    true,
+	"homeworld_id": true,
+	"films":        true,
+	"species":      true,
+	"vehicles":     true,
+	"starships":    true,
+	"created":      true,
+	"edited":       true,
+	"url":          true,
+}
+
+// CharacterProjection is the set of requested fields, a nil projection includes every field.
+type CharacterProjection struct {
+	Fields map[string]bool
+}
+
+// ParseCharacterProjection reads "name,height,homeworld", an empty text is no projection.
+func ParseCharacterProjection(text string) (*CharacterProjection, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+
+	projection := &CharacterProjection{Fields: map[string]bool{}}
+	for _, field := range strings.Split(text, ",") {
+		field = strings.TrimSpace(field)
+		if !CharacterFields[field] {
+			return nil, fmt.Errorf("unknown field %q", field)
+		}
+		projection.Fields[field] = true
+	}
+
+	return projection, nil
+}
+
+func (p *CharacterProjection) Includes(field string) bool {
+	return p == nil || p.Fields[field]
+}
+
+// IncludesLinks tells whether any of the films, species, vehicles or starships is requested.
+func (p *CharacterProjection) IncludesLinks() bool {
+	for _, kind := range LinkKinds {
+		if p.Includes(string(kind)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseCharacterProjection(t *testing.T) {
+
+	projection, err := ParseCharacterProjection("name, height,homeworld")
+
+	assert.Nil(t, err)
+	assert.True(t, projection.Includes("name"))
+	assert.True(t, projection.Includes("homeworld"))
+	assert.False(t, projection.Includes("mass"))
+	assert.False(t, projection.IncludesLinks())
+}
+
+func TestParseCharacterProjectionEmpty(t *testing.T) {
+
+	projection, err := ParseCharacterProjection(" ")
+
+	assert.Nil(t, err)
+	assert.Nil(t, projection)
+	assert.True(t, projection.Includes("mass"))
+	assert.True(t, projection.IncludesLinks())
+}
+
+func TestParseCharacterProjectionError(t *testing.T) {
+
+	testCase := []string{"name,version", "name,", "Name"}
+
+	for _, text := range testCase {
+		t.Run(text, func(t *testing.T) {
+			projection, err := ParseCharacterProjection(text)
+
+			assert.Nil(t, projection)
+			assert.NotNil(t, err)
+		})
+	}
+}
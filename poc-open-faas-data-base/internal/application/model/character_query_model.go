@@ -0,0 +1,45 @@
+package model
+
+const (
+	DefaultPageLimit = 10
+	MaxPageLimit     = 100
+)
+
+type RangeFilter struct {
+	Min *float64
+	Max *float64
+}
+
+type SortOrder struct {
+	Field      string
+	Descending bool
+}
+
+// CharacterQuery restricts the listing to Ids when there are any, used to load many characters in one query.
+type CharacterQuery struct {
+	Ids        []int
+	Height     RangeFilter
+	Mass       RangeFilter
+	BirthYear  RangeFilter
+	Filter     FilterExpression
+	Sort       []SortOrder
+	Projection *CharacterProjection
+	Limit      int
+	Offset     int
+}
+
+type CharacterPage struct {
+	Characters []*CharacterDetail
+	Total      int
+	Limit      int
+	Offset     int
+}
+
+// CharacterSortFields are the fields a character listing can be sorted by.
+var CharacterSortFields = map[string]bool{
+	"id":         true,
+	"name":       true,
+	"height":     true,
+	"mass":       true,
+	"birth_year": true,
+}
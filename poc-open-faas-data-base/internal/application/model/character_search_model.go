@@ -0,0 +1,25 @@
+package model
+
+// MinSearchLength keeps a search from matching every character through a single letter.
+const MinSearchLength = 2
+
+type CharacterSearch struct {
+	Text   string
+	Limit  int
+	Offset int
+}
+
+// CharacterMatch is a character found by a search, Highlights holds the matched
+// fields as escaped HTML, with the matching words wrapped in <em> tags.
+type CharacterMatch struct {
+	Character  *CharacterDetail
+	Score      float64
+	Highlights map[string]string
+}
+
+type CharacterSearchResult struct {
+	Matches []*CharacterMatch
+	Total   int
+	Limit   int
+	Offset  int
+}
@@ -0,0 +1,25 @@
+package model
+
+// CharacterStats aggregates the characters matching a CharacterQuery, its sort and page are ignored.
+type CharacterStats struct {
+	Total       int
+	ByGender    []GroupCount
+	ByEyeColor  []GroupCount
+	ByHomeworld []GroupCount
+	Height      MeasureStats
+	Mass        MeasureStats
+}
+
+type GroupCount struct {
+	Value string
+	Count int
+}
+
+// MeasureStats only considers known values, all of them are nil when none is known.
+type MeasureStats struct {
+	Min     *float64
+	Max     *float64
+	Avg     *float64
+	Unit    string
+	Samples int
+}
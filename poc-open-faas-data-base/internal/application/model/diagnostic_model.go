@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+type DatabaseStatistics struct {
+	AcquiredConns        int32
+	IdleConns            int32
+	ConstructingConns    int32
+	TotalConns           int32
+	MaxConns             int32
+	AcquireCount         int64
+	AcquireDuration      time.Duration
+	EmptyAcquireCount    int64
+	CanceledAcquireCount int64
+	NewConnsCount        int64
+}
+
+const (
+	DependencyUp   = "up"
+	DependencyDown = "down"
+)
+
+type DependencyHealth struct {
+	Name    string
+	Status  string
+	Latency time.Duration
+	Error   string
+}
+
+// Readiness is not ready until the initialization completed and while any dependency is down.
+type Readiness struct {
+	Initialized  bool
+	Dependencies []DependencyHealth
+}
+
+func (r *Readiness) Ready() bool {
+	if !r.Initialized {
+		return false
+	}
+
+	for _, dependency := range r.Dependencies {
+		if dependency.Status != DependencyUp {
+			return false
+		}
+	}
+
+	return true
+}
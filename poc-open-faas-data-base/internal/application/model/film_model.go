@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+type Film struct {
+	Title        string
+	EpisodeId    int
+	OpeningCrawl string
+	Director     string
+	Producer     string
+	ReleaseDate  string
+	Created      time.Time
+	Edited       time.Time
+	Url          string
+}
+
+type FilmIdentifier struct {
+	Id int
+}
+
+type FilmDetail struct {
+	Id   *FilmIdentifier
+	Film *Film
+}
+
+func (f *Film) Timestamps() (*time.Time, *time.Time) {
+	return &f.Created, &f.Edited
+}
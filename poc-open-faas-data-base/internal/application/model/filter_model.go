@@ -0,0 +1,84 @@
+package model
+
+// MaxFilterLength bounds the filter expressions accepted in a listing.
+const MaxFilterLength = 1024
+
+// FilterExpression is a node of a parsed ?filter= expression, built only by ParseFilter
+// so every comparison names a field of CharacterFilterFields with a value of its type.
+type FilterExpression interface {
+	filterExpression()
+}
+
+type LogicalOperator string
+
+const (
+	FilterAnd LogicalOperator = "and"
+	FilterOr  LogicalOperator = "or"
+)
+
+type ComparisonOperator string
+
+const (
+	FilterEq       ComparisonOperator = "eq"
+	FilterNe       ComparisonOperator = "ne"
+	FilterGt       ComparisonOperator = "gt"
+	FilterGe       ComparisonOperator = "ge"
+	FilterLt       ComparisonOperator = "lt"
+	FilterLe       ComparisonOperator = "le"
+	FilterContains ComparisonOperator = "contains"
+)
+
+type FilterLogical struct {
+	Operator LogicalOperator
+	Left     FilterExpression
+	Right    FilterExpression
+}
+
+type FilterNot struct {
+	Expression FilterExpression
+}
+
+// FilterComparison compares a field with a string, a float64 or nil for null.
+// Birth years are already converted to the signed year relative to the Battle of Yavin.
+type FilterComparison struct {
+	Field    string
+	Operator ComparisonOperator
+	Value    any
+}
+
+func (FilterLogical) filterExpression()    {}
+func (FilterNot) filterExpression()        {}
+func (FilterComparison) filterExpression() {}
+
+type FilterFieldType int
+
+const (
+	FilterText FilterFieldType = iota
+	FilterNumber
+	FilterBirthYear
+)
+
+// CharacterFilterFields are the fields a character listing can be filtered by.
+var CharacterFilterFields = map[string]FilterFieldType{
+	"name":       FilterText,
+	"gender":     FilterText,
+	"hair_color": FilterText,
+	"skin_color": FilterText,
+	"eye_color":  FilterText,
+	"height":     FilterNumber,
+	"mass":       FilterNumber,
+	"birth_year": FilterBirthYear,
+}
+
+// filterOperators are the operators each field type accepts.
+var filterOperators = map[FilterFieldType]map[ComparisonOperator]bool{
+	FilterText: {
+		FilterEq: true, FilterNe: true, FilterContains: true,
+	},
+	FilterNumber: {
+		FilterEq: true, FilterNe: true, FilterGt: true, FilterGe: true, FilterLt: true, FilterLe: true,
+	},
+	FilterBirthYear: {
+		FilterEq: true, FilterNe: true, FilterGt: true, FilterGe: true, FilterLt: true, FilterLe: true,
+	},
+}
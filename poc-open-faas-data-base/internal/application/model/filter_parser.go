@@ -0,0 +1,290 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// FilterSyntaxError reports where a filter expression stopped making sense,
+// Position is the 1-based character offset in the expression.
+type FilterSyntaxError struct {
+	Position int
+	Msj      string
+}
+
+func (e *FilterSyntaxError) Error() string {
+	return fmt.Sprintf("position %d: %s", e.Position, e.Msj)
+}
+
+type filterTokenKind int
+
+const (
+	filterEnd filterTokenKind = iota
+	filterWord
+	filterString
+	filterNumber
+	filterOpen
+	filterClose
+)
+
+type filterToken struct {
+	kind     filterTokenKind
+	text     string
+	position int
+}
+
+func (t filterToken) describe() string {
+	switch t.kind {
+	case filterEnd:
+		return "end of filter"
+	case filterString:
+		return strconv.Quote(t.text)
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+// ParseFilter parses expressions such as
+//
+//	gender eq "female" and (height gt 170 or not birth_year lt "19BBY")
+//
+// "and" binds tighter than "or", keywords are case insensitive and text values are double quoted.
+func ParseFilter(text string) (FilterExpression, error) {
+	if len([]rune(text)) > MaxFilterLength {
+		return nil, &FilterSyntaxError{Position: MaxFilterLength + 1, Msj: fmt.Sprintf("filter longer than %d characters", MaxFilterLength)}
+	}
+
+	tokens, err := tokenizeFilter(text)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &filterParser{tokens: tokens}
+
+	expression, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if next := parser.peek(); next.kind != filterEnd {
+		return nil, parser.unexpected(next, "\"and\", \"or\" or end of filter")
+	}
+
+	return expression, nil
+}
+
+func tokenizeFilter(text string) ([]filterToken, error) {
+	runes := []rune(text)
+	tokens := []filterToken{}
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		position := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(':
+			tokens = append(tokens, filterToken{kind: filterOpen, text: "(", position: position})
+			i++
+
+		case r == ')':
+			tokens = append(tokens, filterToken{kind: filterClose, text: ")", position: position})
+			i++
+
+		case r == '"':
+			value := strings.Builder{}
+			closed := false
+			for i++; i < len(runes); i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+					i++
+					value.WriteRune(runes[i])
+					continue
+				}
+				if runes[i] == '"' {
+					closed = true
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+			}
+			if !closed {
+				return nil, &FilterSyntaxError{Position: position, Msj: "unterminated text value"}
+			}
+			tokens = append(tokens, filterToken{kind: filterString, text: value.String(), position: position})
+
+		case r == '-' || r == '.' || unicode.IsDigit(r):
+			start := i
+			for i++; i < len(runes) && (runes[i] == '.' || unicode.IsDigit(runes[i])); i++ {
+			}
+			number := string(runes[start:i])
+			if _, err := strconv.ParseFloat(number, 64); err != nil {
+				return nil, &FilterSyntaxError{Position: position, Msj: fmt.Sprintf("invalid number %q", number)}
+			}
+			tokens = append(tokens, filterToken{kind: filterNumber, text: number, position: position})
+
+		case r == '_' || unicode.IsLetter(r):
+			start := i
+			for i++; i < len(runes) && (runes[i] == '_' || unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i])); i++ {
+			}
+			tokens = append(tokens, filterToken{kind: filterWord, text: string(runes[start:i]), position: position})
+
+		default:
+			return nil, &FilterSyntaxError{Position: position, Msj: fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+
+	return append(tokens, filterToken{kind: filterEnd, position: len(runes) + 1}), nil
+}
+
+type filterParser struct {
+	tokens  []filterToken
+	current int
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.current]
+}
+
+func (p *filterParser) next() filterToken {
+	token := p.tokens[p.current]
+	if token.kind != filterEnd {
+		p.current++
+	}
+	return token
+}
+
+func (p *filterParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return token.kind == filterWord && strings.EqualFold(token.text, keyword)
+}
+
+func (p *filterParser) unexpected(token filterToken, expected string) error {
+	return &FilterSyntaxError{Position: token.position, Msj: fmt.Sprintf("expected %s, found %s", expected, token.describe())}
+}
+
+func (p *filterParser) parseOr() (FilterExpression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword(string(FilterOr)) {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = FilterLogical{Operator: FilterOr, Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (FilterExpression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword(string(FilterAnd)) {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = FilterLogical{Operator: FilterAnd, Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (FilterExpression, error) {
+	if p.isKeyword("not") {
+		p.next()
+		expression, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return FilterNot{Expression: expression}, nil
+	}
+
+	if p.peek().kind == filterOpen {
+		p.next()
+		expression, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != filterClose {
+			return nil, p.unexpected(closing, "\")\"")
+		}
+		return expression, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (FilterExpression, error) {
+	field := p.next()
+	if field.kind != filterWord {
+		return nil, p.unexpected(field, "a field")
+	}
+
+	fieldType, ok := CharacterFilterFields[field.text]
+	if !ok {
+		return nil, &FilterSyntaxError{Position: field.position, Msj: fmt.Sprintf("unknown field %q", field.text)}
+	}
+
+	operatorToken := p.next()
+	if operatorToken.kind != filterWord {
+		return nil, p.unexpected(operatorToken, "an operator")
+	}
+
+	operator := ComparisonOperator(strings.ToLower(operatorToken.text))
+	if !filterOperators[fieldType][operator] {
+		return nil, &FilterSyntaxError{Position: operatorToken.position, Msj: fmt.Sprintf("operator %q not supported by %s", operatorToken.text, field.text)}
+	}
+
+	valueToken := p.next()
+	value, err := filterValue(fieldType, operator, valueToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return FilterComparison{Field: field.text, Operator: operator, Value: value}, nil
+}
+
+// filterValue checks the value against the type of the field, null is only compared with eq and ne.
+func filterValue(fieldType FilterFieldType, operator ComparisonOperator, token filterToken) (any, error) {
+	if token.kind == filterWord && strings.EqualFold(token.text, "null") {
+		if operator != FilterEq && operator != FilterNe {
+			return nil, &FilterSyntaxError{Position: token.position, Msj: fmt.Sprintf("null can only be compared with %s or %s", FilterEq, FilterNe)}
+		}
+		return nil, nil
+	}
+
+	switch {
+	case fieldType == FilterText && token.kind == filterString:
+		return token.text, nil
+
+	case fieldType != FilterText && token.kind == filterNumber:
+		number, _ := strconv.ParseFloat(token.text, 64)
+		return number, nil
+
+	case fieldType == FilterBirthYear && token.kind == filterString:
+		birthYear, err := ParseBirthYear(token.text)
+		if err != nil || !birthYear.Known() {
+			return nil, &FilterSyntaxError{Position: token.position, Msj: fmt.Sprintf("invalid birth year %s, expected a year followed by BBY or ABY", token.describe())}
+		}
+		return *birthYear.Year, nil
+	}
+
+	if fieldType == FilterText {
+		return nil, &FilterSyntaxError{Position: token.position, Msj: fmt.Sprintf("expected a quoted text or null, found %s", token.describe())}
+	}
+
+	return nil, &FilterSyntaxError{Position: token.position, Msj: fmt.Sprintf("expected a number or null, found %s", token.describe())}
+}
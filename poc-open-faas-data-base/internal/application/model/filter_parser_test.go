@@ -0,0 +1,98 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+
+	testCase := []struct {
+		testName   string
+		text       string
+		expression FilterExpression
+	}{
+		{
+			testName:   "text comparison",
+			text:       `gender eq "female"`,
+			expression: FilterComparison{Field: "gender", Operator: FilterEq, Value: "female"},
+		},
+		{
+			testName: "and binds tighter than or",
+			text:     `gender eq "female" or height GT 170 and birth_year lt 0`,
+			expression: FilterLogical{
+				Operator: FilterOr,
+				Left:     FilterComparison{Field: "gender", Operator: FilterEq, Value: "female"},
+				Right: FilterLogical{
+					Operator: FilterAnd,
+					Left:     FilterComparison{Field: "height", Operator: FilterGt, Value: 170.0},
+					Right:    FilterComparison{Field: "birth_year", Operator: FilterLt, Value: 0.0},
+				},
+			},
+		},
+		{
+			testName: "parentheses and not",
+			text:     `not (mass le 80.5 or mass eq null)`,
+			expression: FilterNot{Expression: FilterLogical{
+				Operator: FilterOr,
+				Left:     FilterComparison{Field: "mass", Operator: FilterLe, Value: 80.5},
+				Right:    FilterComparison{Field: "mass", Operator: FilterEq, Value: nil},
+			}},
+		},
+		{
+			testName:   "birth year text",
+			text:       `birth_year ge "19BBY"`,
+			expression: FilterComparison{Field: "birth_year", Operator: FilterGe, Value: -19.0},
+		},
+		{
+			testName:   "escaped quote",
+			text:       `name contains "say \"hi\""`,
+			expression: FilterComparison{Field: "name", Operator: FilterContains, Value: `say "hi"`},
+		},
+	}
+
+	for _, param := range testCase {
+		t.Run(param.testName, func(t *testing.T) {
+			expression, err := ParseFilter(param.text)
+
+			assert.Nil(t, err)
+			assert.Equal(t, param.expression, expression)
+		})
+	}
+}
+
+func TestParseFilterError(t *testing.T) {
+
+	testCase := []struct {
+		testName string
+		text     string
+		position int
+		msj      string
+	}{
+		{testName: "unknown field", text: `gender eq "male" and version gt 1`, position: 22, msj: `unknown field "version"`},
+		{testName: "operator of another type", text: `name gt "a"`, position: 6, msj: `operator "gt" not supported by name`},
+		{testName: "number for a text field", text: `gender eq 1`, position: 11, msj: `expected a quoted text or null, found "1"`},
+		{testName: "text for a number field", text: `height lt "tall"`, position: 11, msj: `expected a number or null, found "tall"`},
+		{testName: "null ordering", text: `mass gt null`, position: 9, msj: "null can only be compared with eq or ne"},
+		{testName: "invalid birth year", text: `birth_year lt "long ago"`, position: 15, msj: `invalid birth year "long ago", expected a year followed by BBY or ABY`},
+		{testName: "unterminated text", text: `name eq "luke`, position: 9, msj: "unterminated text value"},
+		{testName: "injection attempt", text: `name eq "a"; DROP TABLE starwar.character`, position: 12, msj: `unexpected character ';'`},
+		{testName: "missing closing parenthesis", text: `(height gt 1`, position: 13, msj: `expected ")", found end of filter`},
+		{testName: "missing value", text: `height gt`, position: 10, msj: "expected a number or null, found end of filter"},
+		{testName: "dangling and", text: `height gt 1 and`, position: 16, msj: "expected a field, found end of filter"},
+		{testName: "missing logical operator", text: `height gt 1 mass lt 2`, position: 13, msj: `expected "and", "or" or end of filter, found "mass"`},
+		{testName: "invalid number", text: `height gt 1.2.3`, position: 11, msj: `invalid number "1.2.3"`},
+	}
+
+	for _, param := range testCase {
+		t.Run(param.testName, func(t *testing.T) {
+			expression, err := ParseFilter(param.text)
+
+			assert.Nil(t, expression)
+			syntaxError, ok := err.(*FilterSyntaxError)
+			assert.True(t, ok)
+			assert.Equal(t, param.position, syntaxError.Position)
+			assert.Equal(t, param.msj, syntaxError.Msj)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package model
+
+import "time"
+
+// LinkedResource is a resource characters link to, films, species, vehicles and starships.
+// Its timestamps are set by the server unless it is imported.
+type LinkedResource interface {
+	Timestamps() (created *time.Time, edited *time.Time)
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	HeightUnit = "cm"
+	MassUnit   = "kg"
+	unknown    = "unknown"
+)
+
+// measurePattern only lets decimal numbers through, strconv.ParseFloat alone would also accept
+// NaN, Inf, exponents and hexadecimal floats.
+var measurePattern = regexp.MustCompile(`^[0-9][0-9,]*(\.[0-9]+)?$`)
+
+// Measure is a physical attribute as published by SWAPI, a nil Value means "unknown".
+type Measure struct {
+	Value *float64
+	Unit  string
+}
+
+func ParseHeight(text string) (Measure, error) {
+	return parseMeasure("height", text, HeightUnit)
+}
+
+func ParseMass(text string) (Measure, error) {
+	return parseMeasure("mass", text, MassUnit)
+}
+
+func NewMeasure(value *float64, unit string) Measure {
+	return Measure{Value: value, Unit: unit}
+}
+
+// parseMeasure accepts SWAPI values such as "172", "78.2", "1,358" and "unknown".
+func parseMeasure(field string, text string, unit string) (Measure, error) {
+	trimmed := strings.TrimSpace(text)
+
+	if trimmed == "" || strings.EqualFold(trimmed, unknown) {
+		return Measure{Unit: unit}, nil
+	}
+
+	if !measurePattern.MatchString(trimmed) {
+		return Measure{}, fmt.Errorf("invalid %s: %s", field, text)
+	}
+
+	value, err := strconv.ParseFloat(strings.ReplaceAll(trimmed, ",", ""), 64)
+	if err != nil {
+		return Measure{}, fmt.Errorf("invalid %s: %s", field, text)
+	}
+
+	return Measure{Value: &value, Unit: unit}, nil
+}
+
+func (m Measure) Known() bool {
+	return m.Value != nil
+}
+
+// String renders the SWAPI representation, thousands are grouped with a comma.
+func (m Measure) String() string {
+	if m.Value == nil {
+		return unknown
+	}
+
+	number := strconv.FormatFloat(*m.Value, 'f', -1, 64)
+	integer, fraction, hasFraction := strings.Cut(number, ".")
+
+	grouped := ""
+	for len(integer) > 3 {
+		grouped = "," + integer[len(integer)-3:] + grouped
+		integer = integer[:len(integer)-3]
+	}
+	grouped = integer + grouped
+
+	if hasFraction {
+		return grouped + "." + fraction
+	}
+
+	return grouped
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseHeight(t *testing.T) {
+
+	testCase := []struct {
+		testName string
+		text     string
+		value    float64
+		known    bool
+		render   string
+	}{
+		{testName: "integer", text: "172", value: 172, known: true, render: "172"},
+		{testName: "decimal", text: "78.2", value: 78.2, known: true, render: "78.2"},
+		{testName: "thousands separator", text: "1,358", value: 1358, known: true, render: "1,358"},
+		{testName: "unknown", text: "unknown", known: false, render: "unknown"},
+		{testName: "empty", text: "", known: false, render: "unknown"},
+	}
+
+	for _, param := range testCase {
+		t.Run(param.testName, func(t *testing.T) {
+			measure, err := ParseHeight(param.text)
+
+			assert.Nil(t, err)
+			assert.Equal(t, param.known, measure.Known())
+			assert.Equal(t, HeightUnit, measure.Unit)
+			assert.Equal(t, param.render, measure.String())
+			if param.known {
+				assert.Equal(t, param.value, *measure.Value)
+			}
+		})
+	}
+}
+
+func TestParseMassError(t *testing.T) {
+
+	for _, text := range []string{"heavy", "-10", "1.2.3", "NaN", "Inf", "+Inf", "-Inf", "1e3", "0x1p4", ".5", "+10"} {
+		t.Run(text, func(t *testing.T) {
+			_, err := ParseMass(text)
+
+			assert.NotNil(t, err)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+type Planet struct {
+	Name           string
+	RotationPeriod string
+	OrbitalPeriod  string
+	Diameter       string
+	Climate        string
+	Gravity        string
+	Terrain        string
+	SurfaceWater   string
+	Population     string
+	Created        time.Time
+	Edited         time.Time
+	Url            string
+}
+
+type PlanetIdentifier struct {
+	Id int
+}
+
+type PlanetDetail struct {
+	Id      *PlanetIdentifier
+	Planet  *Planet
+	Version int
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+type Species struct {
+	Name            string
+	Classification  string
+	Designation     string
+	AverageHeight   string
+	SkinColors      string
+	HairColors      string
+	EyeColors       string
+	AverageLifespan string
+	Homeworld       string
+	Language        string
+	Created         time.Time
+	Edited          time.Time
+	Url             string
+}
+
+type SpeciesIdentifier struct {
+	Id int
+}
+
+type SpeciesDetail struct {
+	Id      *SpeciesIdentifier
+	Species *Species
+}
+
+func (s *Species) Timestamps() (*time.Time, *time.Time) {
+	return &s.Created, &s.Edited
+}
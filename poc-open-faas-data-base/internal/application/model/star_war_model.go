@@ -1,25 +1,37 @@
 package model
 
+import "time"
+
 type Character struct {
-	Name      string
-	Height    string
-	Mass      string
-	HairColor string
-	SkinColor string
-	EyeColor  string
-	BirthYear string
-	Gender    string
-	Homeworld string
-	Created   string
-	Edited    string
-	Url       string
+	Name        string
+	Height      Measure
+	Mass        Measure
+	HairColor   string
+	SkinColor   string
+	EyeColor    string
+	BirthYear   BirthYear
+	Gender      string
+	Homeworld   string
+	HomeworldId *int
+	Created     time.Time
+	Edited      time.Time
+	Url         string
+	Films       []ResourceLink
+	Species     []ResourceLink
+	Vehicles    []ResourceLink
+	Starships   []ResourceLink
 }
 
 type CharacterIdentifier struct {
 	Id int
 }
 
+// AnyVersion is the version of an update with the If-Match: * precondition, it applies to whatever version is stored.
+// Stored versions start at 1.
+const AnyVersion = 0
+
 type CharacterDetail struct {
 	Id        *CharacterIdentifier
 	Character *Character
+	Version   int
 }
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+type Starship struct {
+	Name                 string
+	Model                string
+	Manufacturer         string
+	CostInCredits        string
+	Length               string
+	MaxAtmospheringSpeed string
+	Crew                 string
+	Passengers           string
+	CargoCapacity        string
+	Consumables          string
+	HyperdriveRating     string
+	MGLT                 string
+	StarshipClass        string
+	Created              time.Time
+	Edited               time.Time
+	Url                  string
+}
+
+type StarshipIdentifier struct {
+	Id int
+}
+
+type StarshipDetail struct {
+	Id       *StarshipIdentifier
+	Starship *Starship
+}
+
+func (s *Starship) Timestamps() (*time.Time, *time.Time) {
+	return &s.Created, &s.Edited
+}
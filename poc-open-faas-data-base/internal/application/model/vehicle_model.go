@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+type Vehicle struct {
+	Name                 string
+	Model                string
+	Manufacturer         string
+	CostInCredits        string
+	Length               string
+	MaxAtmospheringSpeed string
+	Crew                 string
+	Passengers           string
+	CargoCapacity        string
+	Consumables          string
+	VehicleClass         string
+	Created              time.Time
+	Edited               time.Time
+	Url                  string
+}
+
+type VehicleIdentifier struct {
+	Id int
+}
+
+type VehicleDetail struct {
+	Id      *VehicleIdentifier
+	Vehicle *Vehicle
+}
+
+func (v *Vehicle) Timestamps() (*time.Time, *time.Time) {
+	return &v.Created, &v.Edited
+}
@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type CheckReadiness interface {
+	CheckReadiness(ctx context.Context) (*model.Readiness, error)
+}
@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+)
+
+// CreateLinkedResource creates a film, species, vehicle or starship, R is the resource and I its identifier.
+type CreateLinkedResource[R any, I any] interface {
+	Create(resource R, ctx context.Context) (I, error)
+}
@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type CreatePlanet interface {
+	CreatePlanet(planet *model.Planet, ctx context.Context) (*model.PlanetIdentifier, error)
+}
@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type DeletePlanet interface {
+	DeletePlanet(planet *model.PlanetIdentifier, ctx context.Context) error
+}
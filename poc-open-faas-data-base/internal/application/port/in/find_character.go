@@ -7,4 +7,5 @@ import (
 
 type FindCharacter interface {
 	FindCharacter(character *model.CharacterIdentifier, ctx context.Context) (*model.CharacterDetail, error)
+	FindCharacterProjection(character *model.CharacterIdentifier, projection *model.CharacterProjection, ctx context.Context) (*model.CharacterDetail, error)
 }
@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type FindCharacterStats interface {
+	FindCharacterStats(query *model.CharacterQuery, ctx context.Context) (*model.CharacterStats, error)
+}
@@ -0,0 +1,11 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+// FindCharactersByIds answers in the order of the identifiers, with nil for the characters that do not exist.
+type FindCharactersByIds interface {
+	FindCharactersByIds(characters []*model.CharacterIdentifier, ctx context.Context) ([]*model.CharacterDetail, error)
+}
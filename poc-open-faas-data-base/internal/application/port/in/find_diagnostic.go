@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type FindDiagnostic interface {
+	FindDatabaseStatistics(ctx context.Context) (*model.DatabaseStatistics, error)
+}
@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+)
+
+// FindLinkedResource finds a film, species, vehicle or starship, I is its identifier and D its detail.
+type FindLinkedResource[I any, D any] interface {
+	Find(identifier I, ctx context.Context) (D, error)
+}
@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type FindPlanet interface {
+	FindPlanet(planet *model.PlanetIdentifier, ctx context.Context) (*model.PlanetDetail, error)
+}
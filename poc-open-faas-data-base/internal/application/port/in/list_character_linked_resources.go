@@ -0,0 +1,11 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+// ListCharacterLinkedResources lists the films, species, vehicles or starships of a character.
+type ListCharacterLinkedResources[D any] interface {
+	ListByCharacter(character *model.CharacterIdentifier, ctx context.Context) ([]D, error)
+}
@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type ListCharacters interface {
+	ListCharacters(query *model.CharacterQuery, ctx context.Context) (*model.CharacterPage, error)
+}
@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type ListLinkedCharacters interface {
+	ListLinkedCharacters(link *model.CharacterLink, ctx context.Context) (*model.CharacterPage, error)
+}
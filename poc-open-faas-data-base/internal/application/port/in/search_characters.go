@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type SearchCharacters interface {
+	SearchCharacters(search *model.CharacterSearch, ctx context.Context) (*model.CharacterSearchResult, error)
+}
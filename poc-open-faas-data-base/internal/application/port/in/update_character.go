@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type UpdateCharacter interface {
+	UpdateCharacter(character *model.CharacterDetail, ctx context.Context) (*model.CharacterDetail, error)
+}
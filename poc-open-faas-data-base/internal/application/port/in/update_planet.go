@@ -0,0 +1,10 @@
+package in
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type UpdatePlanet interface {
+	UpdatePlanet(planet *model.PlanetDetail, ctx context.Context) (*model.PlanetDetail, error)
+}
@@ -8,4 +8,5 @@ import (
 type StarwarCache interface {
 	SaveCharacter(character *model.CharacterDetail, ctx context.Context) error
 	FindCharacterById(characterIdentifier *model.CharacterIdentifier, ctx context.Context) (*model.CharacterDetail, error)
+	DeleteCharacter(characterIdentifier *model.CharacterIdentifier, ctx context.Context) error
 }
@@ -0,0 +1,10 @@
+package out
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type CharacterLinkRepository interface {
+	FindLinkedCharacters(link *model.CharacterLink, ctx context.Context) (*model.CharacterPage, error)
+}
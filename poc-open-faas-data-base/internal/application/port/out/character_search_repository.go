@@ -0,0 +1,10 @@
+package out
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type CharacterSearchRepository interface {
+	SearchCharacters(search *model.CharacterSearch, ctx context.Context) (*model.CharacterSearchResult, error)
+}
@@ -0,0 +1,12 @@
+package out
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+// CharacterSource is an upstream SWAPI style dataset read when a character is not stored locally.
+// FindCharacterById returns nil without error when the upstream does not know the character either.
+type CharacterSource interface {
+	FindCharacterById(character *model.CharacterIdentifier, ctx context.Context) (*model.Character, error)
+}
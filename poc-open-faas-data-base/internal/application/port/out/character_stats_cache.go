@@ -0,0 +1,12 @@
+package out
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+// CharacterStatsCache keys the statistics by the filters of the query, a miss returns nil, nil.
+type CharacterStatsCache interface {
+	SaveCharacterStats(query *model.CharacterQuery, stats *model.CharacterStats, ctx context.Context) error
+	FindCharacterStats(query *model.CharacterQuery, ctx context.Context) (*model.CharacterStats, error)
+}
@@ -0,0 +1,10 @@
+package out
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type CharacterStatsRepository interface {
+	FindCharacterStats(query *model.CharacterQuery, ctx context.Context) (*model.CharacterStats, error)
+}
@@ -0,0 +1,7 @@
+package out
+
+import "time"
+
+type Clock interface {
+	Now() time.Time
+}
@@ -0,0 +1,14 @@
+package out
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type DatabaseDiagnostic interface {
+	DatabaseStatistics(ctx context.Context) (*model.DatabaseStatistics, error)
+}
+
+type DependencyPinger interface {
+	Ping(ctx context.Context) error
+}
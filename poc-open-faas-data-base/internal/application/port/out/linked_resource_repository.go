@@ -0,0 +1,14 @@
+package out
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+// LinkedResourceRepository stores the films, species, vehicles or starships, R is the resource,
+// I its identifier and D its detail.
+type LinkedResourceRepository[R any, I any, D any] interface {
+	Create(resource R, ctx context.Context) (I, error)
+	FindById(identifier I, ctx context.Context) (D, error)
+	FindByCharacter(character *model.CharacterIdentifier, ctx context.Context) ([]D, error)
+}
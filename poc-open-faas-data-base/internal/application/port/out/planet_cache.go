@@ -0,0 +1,12 @@
+package out
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type PlanetCache interface {
+	SavePlanet(planet *model.PlanetDetail, ctx context.Context) error
+	FindPlanetById(planetIdentifier *model.PlanetIdentifier, ctx context.Context) (*model.PlanetDetail, error)
+	DeletePlanet(planetIdentifier *model.PlanetIdentifier, ctx context.Context) error
+}
@@ -0,0 +1,13 @@
+package out
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+)
+
+type PlanetRepository interface {
+	CreatePlanet(planet *model.Planet, ctx context.Context) (*model.PlanetIdentifier, error)
+	FindPlanetById(planet *model.PlanetIdentifier, ctx context.Context) (*model.PlanetDetail, error)
+	UpdatePlanet(planet *model.PlanetDetail, ctx context.Context) (*model.PlanetDetail, error)
+	DeletePlanet(planet *model.PlanetIdentifier, ctx context.Context) error
+}
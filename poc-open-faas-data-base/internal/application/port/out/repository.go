@@ -8,4 +8,8 @@ import (
 type StarwarRepository interface {
 	CreateCharacter(character *model.Character, ctx context.Context) (*model.CharacterIdentifier, error)
 	FindCharacterById(character *model.CharacterIdentifier, ctx context.Context) (*model.CharacterDetail, error)
+	FindCharacterProjection(character *model.CharacterIdentifier, projection *model.CharacterProjection, ctx context.Context) (*model.CharacterDetail, error)
+	FindCharacters(query *model.CharacterQuery, ctx context.Context) (*model.CharacterPage, error)
+	UpdateCharacter(character *model.CharacterDetail, ctx context.Context) (*model.CharacterDetail, error)
+	ImportCharacter(character *model.CharacterDetail, ctx context.Context) (*model.CharacterDetail, error)
 }
@@ -0,0 +1,9 @@
+package out
+
+import (
+	"context"
+)
+
+type UnitOfWork interface {
+	WithinTransaction(transactional func(ctx context.Context) error, ctx context.Context) error
+}
@@ -0,0 +1,84 @@
+package diagnostic
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+	"sort"
+	"sync"
+	"time"
+)
+
+var _ in.CheckReadiness = (*CheckReadiness)(nil)
+
+// pingTimeout keeps a dependency that does not answer from holding the probe.
+const pingTimeout = 2 * time.Second
+
+// CheckReadiness is created before the dependencies exist, so the probes are answered while the function initializes.
+type CheckReadiness struct {
+	clock        out.Clock
+	mutex        sync.RWMutex
+	initialized  bool
+	dependencies map[string]out.DependencyPinger
+}
+
+func NewCheckReadinessUseCase(clock out.Clock) *CheckReadiness {
+	return &CheckReadiness{
+		clock: clock,
+	}
+}
+
+// CompleteInitialization marks the function as initialized, from then on the dependencies are pinged on every check.
+func (c *CheckReadiness) CompleteInitialization(dependencies map[string]out.DependencyPinger) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.initialized = true
+	c.dependencies = dependencies
+}
+
+func (c *CheckReadiness) CheckReadiness(ctx context.Context) (*model.Readiness, error) {
+	c.mutex.RLock()
+	initialized := c.initialized
+	dependencies := c.dependencies
+	c.mutex.RUnlock()
+
+	names := make([]string, 0, len(dependencies))
+	for name := range dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// the dependencies are pinged at the same time, the probe takes as long as the slowest one.
+	health := make([]model.DependencyHealth, len(names))
+	wait := sync.WaitGroup{}
+	for i, name := range names {
+		wait.Add(1)
+		go func(i int, name string) {
+			defer wait.Done()
+			health[i] = c.ping(name, dependencies[name], ctx)
+		}(i, name)
+	}
+	wait.Wait()
+
+	return &model.Readiness{
+		Initialized:  initialized,
+		Dependencies: health,
+	}, nil
+}
+
+func (c *CheckReadiness) ping(name string, dependency out.DependencyPinger, ctx context.Context) model.DependencyHealth {
+	pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	start := c.clock.Now()
+	err := dependency.Ping(pingCtx)
+	latency := c.clock.Now().Sub(start)
+
+	if err != nil {
+		return model.DependencyHealth{Name: name, Status: model.DependencyDown, Latency: latency, Error: err.Error()}
+	}
+
+	return model.DependencyHealth{Name: name, Status: model.DependencyUp, Latency: latency}
+}
@@ -0,0 +1,111 @@
+package diagnostic
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/out"
+	"sync"
+	"testing"
+	"time"
+)
+
+type DependencyPingerMock struct {
+	mock.Mock
+}
+
+func (d *DependencyPingerMock) Ping(ctx context.Context) error {
+	args := d.Called(ctx)
+	return args.Error(0)
+}
+
+// ClockMock moves a millisecond forward on every read, so every ping takes some time.
+type ClockMock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func (c *ClockMock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = c.now.Add(time.Millisecond)
+	return c.now
+}
+
+func TestCheckReadiness_CheckReadinessNotInitialized(t *testing.T) {
+	useCase := NewCheckReadinessUseCase(&ClockMock{})
+
+	readiness, err := useCase.CheckReadiness(context.Background())
+
+	assert.Nil(t, err)
+	assert.False(t, readiness.Initialized)
+	assert.Empty(t, readiness.Dependencies)
+	assert.False(t, readiness.Ready())
+}
+
+func TestCheckReadiness_CheckReadiness(t *testing.T) {
+
+	type testCase struct {
+		name     string
+		cacheErr error
+		ready    bool
+		statuses []string
+	}
+
+	testCases := []testCase{
+		{name: "every dependency up", ready: true, statuses: []string{model.DependencyUp, model.DependencyUp}},
+		{name: "cache down", cacheErr: fmt.Errorf("dial tcp: connection refused"), ready: false, statuses: []string{model.DependencyDown, model.DependencyUp}},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			databaseMock := DependencyPingerMock{}
+			databaseMock.On("Ping", mock.Anything).Return(nil)
+
+			cacheMock := DependencyPingerMock{}
+			cacheMock.On("Ping", mock.Anything).Return(test.cacheErr)
+
+			useCase := NewCheckReadinessUseCase(&ClockMock{})
+			useCase.CompleteInitialization(map[string]out.DependencyPinger{
+				"database": &databaseMock,
+				"cache":    &cacheMock,
+			})
+
+			readiness, err := useCase.CheckReadiness(context.Background())
+
+			assert.Nil(t, err)
+			assert.True(t, readiness.Initialized)
+			assert.EqualValues(t, test.ready, readiness.Ready())
+			assert.Len(t, readiness.Dependencies, 2)
+			assert.EqualValues(t, "cache", readiness.Dependencies[0].Name)
+			assert.EqualValues(t, "database", readiness.Dependencies[1].Name)
+			for i, dependency := range readiness.Dependencies {
+				assert.EqualValues(t, test.statuses[i], dependency.Status)
+				assert.Greater(t, dependency.Latency, time.Duration(0))
+			}
+			if test.cacheErr != nil {
+				assert.EqualValues(t, test.cacheErr.Error(), readiness.Dependencies[0].Error)
+			}
+		})
+	}
+}
+
+func TestCheckReadiness_CheckReadinessPingTimeout(t *testing.T) {
+	databaseMock := DependencyPingerMock{}
+	databaseMock.On("Ping", mock.MatchedBy(func(ctx context.Context) bool {
+		_, hasDeadline := ctx.Deadline()
+		return hasDeadline
+	})).Return(context.DeadlineExceeded)
+
+	useCase := NewCheckReadinessUseCase(&ClockMock{})
+	useCase.CompleteInitialization(map[string]out.DependencyPinger{"database": &databaseMock})
+
+	readiness, err := useCase.CheckReadiness(context.Background())
+
+	assert.Nil(t, err)
+	assert.False(t, readiness.Ready())
+	assert.EqualValues(t, "context deadline exceeded", readiness.Dependencies[0].Error)
+}
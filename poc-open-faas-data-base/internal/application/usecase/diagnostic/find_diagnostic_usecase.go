@@ -0,0 +1,24 @@
+package diagnostic
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+)
+
+var _ in.FindDiagnostic = (*FindDiagnostic)(nil)
+
+type FindDiagnostic struct {
+	databaseDiagnostic out.DatabaseDiagnostic
+}
+
+func NewFindDiagnosticUseCase(databaseDiagnostic out.DatabaseDiagnostic) *FindDiagnostic {
+	return &FindDiagnostic{
+		databaseDiagnostic: databaseDiagnostic,
+	}
+}
+
+func (f *FindDiagnostic) FindDatabaseStatistics(ctx context.Context) (*model.DatabaseStatistics, error) {
+	return f.databaseDiagnostic.DatabaseStatistics(ctx)
+}
@@ -0,0 +1,82 @@
+package diagnostic
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var databaseStatistics = model.DatabaseStatistics{
+	AcquiredConns:     1,
+	IdleConns:         3,
+	TotalConns:        4,
+	MaxConns:          10,
+	AcquireCount:      25,
+	AcquireDuration:   time.Duration(150) * time.Millisecond,
+	EmptyAcquireCount: 2,
+}
+
+type DatabaseDiagnosticMock struct {
+	mock.Mock
+}
+
+func (d *DatabaseDiagnosticMock) DatabaseStatistics(ctx context.Context) (*model.DatabaseStatistics, error) {
+	args := d.Called(ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	statistics := firstParameter.(*model.DatabaseStatistics)
+
+	return statistics, nil
+}
+
+func TestFindDiagnostic_FindDatabaseStatistics(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/diagnostics/database",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	diagnosticMock := new(DatabaseDiagnosticMock)
+	diagnosticMock.On("DatabaseStatistics", ctx).Return(&databaseStatistics, nil)
+
+	useCase := NewFindDiagnosticUseCase(diagnosticMock)
+
+	statistics, err := useCase.FindDatabaseStatistics(ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &databaseStatistics, statistics)
+}
+
+func TestFindDiagnostic_FindDatabaseStatisticsError(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/diagnostics/database",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	diagnosticMock := new(DatabaseDiagnosticMock)
+	diagnosticMock.On("DatabaseStatistics", ctx).Return(nil, fmt.Errorf("generic error"))
+
+	useCase := NewFindDiagnosticUseCase(diagnosticMock)
+
+	statistics, err := useCase.FindDatabaseStatistics(ctx)
+
+	assert.Nil(t, statistics)
+	assert.Equal(t, "generic error", err.Error())
+}
@@ -11,14 +11,48 @@ var _ in.CreateCharacter = (*CreateCharacter)(nil)
 
 type CreateCharacter struct {
 	starwarRepository out.StarwarRepository
+	unitOfWork        out.UnitOfWork
+	clock             out.Clock
 }
 
-func NewCreateCharacterUseCase(starwarRepository out.StarwarRepository) *CreateCharacter {
+func NewCreateCharacterUseCase(
+	starwarRepository out.StarwarRepository,
+	unitOfWork out.UnitOfWork,
+	clock out.Clock) *CreateCharacter {
+
 	return &CreateCharacter{
 		starwarRepository: starwarRepository,
+		unitOfWork:        unitOfWork,
+		clock:             clock,
 	}
 }
 
+// CreateCharacter stamps created and edited with the current time, unless they were already
+// set by an import of the original dataset.
 func (c *CreateCharacter) CreateCharacter(character *model.Character, ctx context.Context) (*model.CharacterIdentifier, error) {
-	return c.starwarRepository.CreateCharacter(character, ctx)
+	var characterIdentifier *model.CharacterIdentifier
+
+	if character.Created.IsZero() {
+		character.Created = c.clock.Now()
+	}
+
+	if character.Edited.IsZero() {
+		character.Edited = character.Created
+	}
+
+	err := c.unitOfWork.WithinTransaction(func(ctx context.Context) error {
+		createResult, err := c.starwarRepository.CreateCharacter(character, ctx)
+		if err != nil {
+			return err
+		}
+
+		characterIdentifier = createResult
+		return nil
+	}, ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return characterIdentifier, nil
 }
@@ -2,12 +2,14 @@ package starwar
 
 import (
 	"context"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"handler/function/internal/application/model"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 var characterCreateIdentifier = model.CharacterIdentifier{
@@ -44,6 +46,82 @@ func (s *StarwarRepositoryCreateMock) FindCharacterById(character *model.Charact
 	return characterDetail, nil
 }
 
+func (s *StarwarRepositoryCreateMock) UpdateCharacter(character *model.CharacterDetail, ctx context.Context) (*model.CharacterDetail, error) {
+	args := s.Called(character, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	characterDetail := firstParameter.(*model.CharacterDetail)
+
+	return characterDetail, nil
+}
+
+type ClockMock struct {
+	now time.Time
+}
+
+func (c *ClockMock) Now() time.Time {
+	return c.now
+}
+
+var clockMock = &ClockMock{now: time.Date(2023, 4, 1, 10, 30, 0, 0, time.UTC)}
+
+func (s *StarwarRepositoryCreateMock) FindCharacterProjection(character *model.CharacterIdentifier, projection *model.CharacterProjection, ctx context.Context) (*model.CharacterDetail, error) {
+	args := s.Called(character, projection, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	characterDetail := firstParameter.(*model.CharacterDetail)
+
+	return characterDetail, nil
+}
+
+func (s *StarwarRepositoryCreateMock) FindCharacters(query *model.CharacterQuery, ctx context.Context) (*model.CharacterPage, error) {
+	args := s.Called(query, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	characterPage := firstParameter.(*model.CharacterPage)
+
+	return characterPage, nil
+}
+
+func (s *StarwarRepositoryCreateMock) ImportCharacter(character *model.CharacterDetail, ctx context.Context) (*model.CharacterDetail, error) {
+	args := s.Called(character, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	characterDetail := firstParameter.(*model.CharacterDetail)
+
+	return characterDetail, nil
+}
+
+type UnitOfWorkMock struct {
+	mock.Mock
+}
+
+func (u *UnitOfWorkMock) WithinTransaction(transactional func(ctx context.Context) error, ctx context.Context) error {
+	args := u.Called(ctx)
+
+	if args.Error(0) != nil {
+		return args.Error(0)
+	}
+
+	return transactional(ctx)
+}
+
 func TestCreateCharacter_CreateCharacter(t *testing.T) {
 
 	req := httptest.NewRequest(
@@ -58,7 +136,10 @@ func TestCreateCharacter_CreateCharacter(t *testing.T) {
 	createRepositoryMock.On("CreateCharacter", mock.IsType(&model.Character{}), ctx).
 		Return(&characterCreateIdentifier, nil)
 
-	useCase := NewCreateCharacterUseCase(createRepositoryMock)
+	unitOfWorkMock := new(UnitOfWorkMock)
+	unitOfWorkMock.On("WithinTransaction", ctx).Return(nil)
+
+	useCase := NewCreateCharacterUseCase(createRepositoryMock, unitOfWorkMock, clockMock)
 
 	characterIdentifier, err := useCase.CreateCharacter(&character, ctx)
 
@@ -66,3 +147,112 @@ func TestCreateCharacter_CreateCharacter(t *testing.T) {
 	assert.Equal(t, &characterCreateIdentifier, characterIdentifier)
 
 }
+
+func TestCreateCharacter_CreateCharacterRepositoryError(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/starwar/characters",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	createRepositoryMock := new(StarwarRepositoryCreateMock)
+	createRepositoryMock.On("CreateCharacter", mock.IsType(&model.Character{}), ctx).
+		Return(nil, fmt.Errorf("generic error"))
+
+	unitOfWorkMock := new(UnitOfWorkMock)
+	unitOfWorkMock.On("WithinTransaction", ctx).Return(nil)
+
+	useCase := NewCreateCharacterUseCase(createRepositoryMock, unitOfWorkMock, clockMock)
+
+	characterIdentifier, err := useCase.CreateCharacter(&character, ctx)
+
+	assert.Nil(t, characterIdentifier)
+	assert.Equal(t, "generic error", err.Error())
+
+}
+
+func TestCreateCharacter_CreateCharacterTransactionError(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/starwar/characters",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	createRepositoryMock := new(StarwarRepositoryCreateMock)
+
+	unitOfWorkMock := new(UnitOfWorkMock)
+	unitOfWorkMock.On("WithinTransaction", ctx).Return(fmt.Errorf("transaction error"))
+
+	useCase := NewCreateCharacterUseCase(createRepositoryMock, unitOfWorkMock, clockMock)
+
+	characterIdentifier, err := useCase.CreateCharacter(&character, ctx)
+
+	assert.Nil(t, characterIdentifier)
+	assert.Equal(t, "transaction error", err.Error())
+	createRepositoryMock.AssertNotCalled(t, "CreateCharacter", mock.Anything, mock.Anything)
+
+}
+
+func TestCreateCharacter_CreateCharacterServerTimestamps(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/starwar/characters",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	newCharacter := model.Character{Name: "Darth Ezequiel"}
+
+	createRepositoryMock := new(StarwarRepositoryCreateMock)
+	createRepositoryMock.On("CreateCharacter", mock.IsType(&model.Character{}), ctx).
+		Return(&characterCreateIdentifier, nil)
+
+	unitOfWorkMock := new(UnitOfWorkMock)
+	unitOfWorkMock.On("WithinTransaction", ctx).Return(nil)
+
+	useCase := NewCreateCharacterUseCase(createRepositoryMock, unitOfWorkMock, clockMock)
+
+	_, err := useCase.CreateCharacter(&newCharacter, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, clockMock.now, newCharacter.Created)
+	assert.Equal(t, clockMock.now, newCharacter.Edited)
+
+}
+
+func TestCreateCharacter_CreateCharacterImportedTimestamps(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/starwar/characters?mode=import",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	importedCharacter := character
+
+	createRepositoryMock := new(StarwarRepositoryCreateMock)
+	createRepositoryMock.On("CreateCharacter", mock.IsType(&model.Character{}), ctx).
+		Return(&characterCreateIdentifier, nil)
+
+	unitOfWorkMock := new(UnitOfWorkMock)
+	unitOfWorkMock.On("WithinTransaction", ctx).Return(nil)
+
+	useCase := NewCreateCharacterUseCase(createRepositoryMock, unitOfWorkMock, clockMock)
+
+	_, err := useCase.CreateCharacter(&importedCharacter, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, character.Created, importedCharacter.Created)
+	assert.Equal(t, character.Edited, importedCharacter.Edited)
+
+}
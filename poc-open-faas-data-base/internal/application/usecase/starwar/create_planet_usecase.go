@@ -0,0 +1,35 @@
+package starwar
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+)
+
+var _ in.CreatePlanet = (*CreatePlanet)(nil)
+
+type CreatePlanet struct {
+	planetRepository out.PlanetRepository
+	clock            out.Clock
+}
+
+func NewCreatePlanetUseCase(planetRepository out.PlanetRepository, clock out.Clock) *CreatePlanet {
+	return &CreatePlanet{
+		planetRepository: planetRepository,
+		clock:            clock,
+	}
+}
+
+func (c *CreatePlanet) CreatePlanet(planet *model.Planet, ctx context.Context) (*model.PlanetIdentifier, error) {
+
+	if planet.Created.IsZero() {
+		planet.Created = c.clock.Now()
+	}
+
+	if planet.Edited.IsZero() {
+		planet.Edited = planet.Created
+	}
+
+	return c.planetRepository.CreatePlanet(planet, ctx)
+}
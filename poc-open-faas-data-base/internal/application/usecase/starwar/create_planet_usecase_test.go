@@ -0,0 +1,36 @@
+package starwar
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreatePlanet_CreatePlanet(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/starwar/planets",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	newPlanet := model.Planet{Name: "Tatooine"}
+
+	repositoryMock := new(PlanetRepositoryMock)
+	repositoryMock.On("CreatePlanet", mock.IsType(&model.Planet{}), ctx).
+		Return(&PlanetIdentifier, nil)
+
+	useCase := NewCreatePlanetUseCase(repositoryMock, clockMock)
+
+	planetIdentifier, err := useCase.CreatePlanet(&newPlanet, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &PlanetIdentifier, planetIdentifier)
+	assert.Equal(t, clockMock.now, newPlanet.Created)
+	assert.Equal(t, clockMock.now, newPlanet.Edited)
+}
@@ -0,0 +1,43 @@
+package starwar
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+	"log"
+)
+
+var _ in.DeletePlanet = (*DeletePlanet)(nil)
+
+type DeletePlanet struct {
+	planetRepository out.PlanetRepository
+	planetCache      out.PlanetCache
+}
+
+func NewDeletePlanetUseCase(
+	planetRepository out.PlanetRepository,
+	planetCache out.PlanetCache) *DeletePlanet {
+
+	return &DeletePlanet{
+		planetRepository: planetRepository,
+		planetCache:      planetCache,
+	}
+}
+
+// DeletePlanet leaves the characters living there without homeworld, the foreign key is set to null.
+// The planet is deleted once the repository succeeds, an eviction that fails is only logged.
+func (d *DeletePlanet) DeletePlanet(planetIdentifier *model.PlanetIdentifier, ctx context.Context) error {
+
+	err := d.planetRepository.DeletePlanet(planetIdentifier, ctx)
+	if err != nil {
+		return err
+	}
+
+	errorDeleteCache := d.planetCache.DeletePlanet(planetIdentifier, ctx)
+	if errorDeleteCache != nil {
+		log.Printf("Error evicting deleted planet %d from cache %s\n", planetIdentifier.Id, errorDeleteCache.Error())
+	}
+
+	return nil
+}
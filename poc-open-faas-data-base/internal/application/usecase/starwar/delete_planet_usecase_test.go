@@ -0,0 +1,88 @@
+package starwar
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDeletePlanet_DeletePlanet(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodDelete,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(PlanetRepositoryMock)
+	repositoryMock.On("DeletePlanet", mock.IsType(&model.PlanetIdentifier{}), ctx).
+		Return(nil)
+
+	cacheMock := new(PlanetCacheMock)
+	cacheMock.On("DeletePlanet", mock.IsType(&model.PlanetIdentifier{}), ctx).
+		Return(nil)
+
+	useCase := NewDeletePlanetUseCase(repositoryMock, cacheMock)
+
+	err := useCase.DeletePlanet(&PlanetIdentifier, ctx)
+
+	assert.Nil(t, err)
+	cacheMock.AssertCalled(t, "DeletePlanet", &PlanetIdentifier, ctx)
+}
+
+func TestDeletePlanet_DeletePlanetNotFound(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodDelete,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(PlanetRepositoryMock)
+	repositoryMock.On("DeletePlanet", mock.IsType(&model.PlanetIdentifier{}), ctx).
+		Return(pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Planet Not Found"})
+
+	cacheMock := new(PlanetCacheMock)
+
+	useCase := NewDeletePlanetUseCase(repositoryMock, cacheMock)
+
+	err := useCase.DeletePlanet(&PlanetIdentifier, ctx)
+
+	statusCode, _ := pkg.GetErrorDetail(err)
+
+	assert.Equal(t, http.StatusNotFound, statusCode)
+	cacheMock.AssertNotCalled(t, "DeletePlanet", mock.Anything, mock.Anything)
+}
+
+func TestDeletePlanet_DeletePlanetCacheError(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodDelete,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(PlanetRepositoryMock)
+	repositoryMock.On("DeletePlanet", mock.IsType(&model.PlanetIdentifier{}), ctx).
+		Return(nil)
+
+	cacheMock := new(PlanetCacheMock)
+	cacheMock.On("DeletePlanet", mock.IsType(&model.PlanetIdentifier{}), ctx).
+		Return(pkg.GenericException{StatusCode: http.StatusInternalServerError, Msj: "cache error"})
+
+	useCase := NewDeletePlanetUseCase(repositoryMock, cacheMock)
+
+	err := useCase.DeletePlanet(&PlanetIdentifier, ctx)
+
+	assert.Nil(t, err)
+}
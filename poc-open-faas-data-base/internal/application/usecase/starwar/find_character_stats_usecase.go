@@ -0,0 +1,54 @@
+package starwar
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+	"log"
+)
+
+var _ in.FindCharacterStats = (*FindCharacterStats)(nil)
+
+type FindCharacterStats struct {
+	statsRepository out.CharacterStatsRepository
+	statsCache      out.CharacterStatsCache
+}
+
+func NewFindCharacterStatsUseCase(
+	statsRepository out.CharacterStatsRepository,
+	statsCache out.CharacterStatsCache) *FindCharacterStats {
+
+	return &FindCharacterStats{
+		statsRepository: statsRepository,
+		statsCache:      statsCache,
+	}
+}
+
+// FindCharacterStats is not invalidated on writes, the statistics are as fresh as the stats ttl.
+func (f *FindCharacterStats) FindCharacterStats(query *model.CharacterQuery, ctx context.Context) (*model.CharacterStats, error) {
+
+	findResult, err := f.statsCache.FindCharacterStats(query, ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if findResult != nil {
+		return findResult, nil
+	}
+
+	stats, err := f.statsRepository.FindCharacterStats(query, ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the statistics are already read, a failed write back only costs the next request a query.
+	errorSaveCache := f.statsCache.SaveCharacterStats(query, stats, ctx)
+	if errorSaveCache != nil {
+		log.Printf("Error caching character statistics %s\n", errorSaveCache.Error())
+	}
+
+	return stats, nil
+}
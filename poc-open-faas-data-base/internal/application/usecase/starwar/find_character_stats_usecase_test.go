@@ -0,0 +1,153 @@
+package starwar
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type CharacterStatsRepositoryMock struct {
+	mock.Mock
+}
+
+type CharacterStatsCacheMock struct {
+	mock.Mock
+}
+
+func (s *CharacterStatsRepositoryMock) FindCharacterStats(
+	query *model.CharacterQuery,
+	ctx context.Context) (*model.CharacterStats, error) {
+
+	args := s.Called(query, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	if args.Get(0) == nil {
+		return nil, nil
+	}
+
+	return args.Get(0).(*model.CharacterStats), nil
+}
+
+func (s *CharacterStatsCacheMock) FindCharacterStats(
+	query *model.CharacterQuery,
+	ctx context.Context) (*model.CharacterStats, error) {
+
+	args := s.Called(query, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	if args.Get(0) == nil {
+		return nil, nil
+	}
+
+	return args.Get(0).(*model.CharacterStats), nil
+}
+
+func (s *CharacterStatsCacheMock) SaveCharacterStats(
+	query *model.CharacterQuery,
+	stats *model.CharacterStats,
+	ctx context.Context) error {
+
+	args := s.Called(query, stats, ctx)
+
+	return args.Error(0)
+}
+
+var CharacterStats = model.CharacterStats{
+	Total:       1,
+	ByGender:    []model.GroupCount{{Value: "male", Count: 1}},
+	ByEyeColor:  []model.GroupCount{{Value: "yellow", Count: 1}},
+	ByHomeworld: []model.GroupCount{{Value: "https://swapi.dev/api/planets/1/", Count: 1}},
+	Height:      model.MeasureStats{Min: &characterHeight, Max: &characterHeight, Avg: &characterHeight, Unit: model.HeightUnit, Samples: 1},
+	Mass:        model.MeasureStats{Min: &characterMass, Max: &characterMass, Avg: &characterMass, Unit: model.MassUnit, Samples: 1},
+}
+
+func TestFindCharacterStats_FindCharacterStatsFromCache(t *testing.T) {
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/stats", nil)
+	ctx := req.Context()
+	query := &model.CharacterQuery{}
+
+	repositoryMock := new(CharacterStatsRepositoryMock)
+	cacheMock := new(CharacterStatsCacheMock)
+	cacheMock.On("FindCharacterStats", query, ctx).Return(&CharacterStats, nil)
+
+	useCase := NewFindCharacterStatsUseCase(repositoryMock, cacheMock)
+
+	stats, err := useCase.FindCharacterStats(query, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &CharacterStats, stats)
+	repositoryMock.AssertNotCalled(t, "FindCharacterStats", mock.Anything, mock.Anything)
+}
+
+func TestFindCharacterStats_FindCharacterStatsFromRepository(t *testing.T) {
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/stats", nil)
+	ctx := req.Context()
+	query := &model.CharacterQuery{}
+
+	repositoryMock := new(CharacterStatsRepositoryMock)
+	repositoryMock.On("FindCharacterStats", query, ctx).Return(&CharacterStats, nil)
+	cacheMock := new(CharacterStatsCacheMock)
+	cacheMock.On("FindCharacterStats", query, ctx).Return(nil, nil)
+	cacheMock.On("SaveCharacterStats", query, &CharacterStats, ctx).Return(nil)
+
+	useCase := NewFindCharacterStatsUseCase(repositoryMock, cacheMock)
+
+	stats, err := useCase.FindCharacterStats(query, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &CharacterStats, stats)
+	cacheMock.AssertExpectations(t)
+}
+
+func TestFindCharacterStats_FindCharacterStatsSaveCacheError(t *testing.T) {
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/stats", nil)
+	ctx := req.Context()
+	query := &model.CharacterQuery{}
+
+	repositoryMock := new(CharacterStatsRepositoryMock)
+	repositoryMock.On("FindCharacterStats", query, ctx).Return(&CharacterStats, nil)
+	cacheMock := new(CharacterStatsCacheMock)
+	cacheMock.On("FindCharacterStats", query, ctx).Return(nil, nil)
+	cacheMock.On("SaveCharacterStats", query, &CharacterStats, ctx).Return(fmt.Errorf("cache error"))
+
+	useCase := NewFindCharacterStatsUseCase(repositoryMock, cacheMock)
+
+	stats, err := useCase.FindCharacterStats(query, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &CharacterStats, stats)
+}
+
+func TestFindCharacterStats_FindCharacterStatsError(t *testing.T) {
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/stats", nil)
+	ctx := req.Context()
+	query := &model.CharacterQuery{}
+
+	repositoryMock := new(CharacterStatsRepositoryMock)
+	repositoryMock.On("FindCharacterStats", query, ctx).Return(nil, fmt.Errorf("generic error"))
+	cacheMock := new(CharacterStatsCacheMock)
+	cacheMock.On("FindCharacterStats", query, ctx).Return(nil, nil)
+
+	useCase := NewFindCharacterStatsUseCase(repositoryMock, cacheMock)
+
+	stats, err := useCase.FindCharacterStats(query, ctx)
+
+	assert.Nil(t, stats)
+	assert.NotNil(t, err)
+	cacheMock.AssertNotCalled(t, "SaveCharacterStats", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -5,6 +5,8 @@ import (
 	"handler/function/internal/application/model"
 	"handler/function/internal/application/port/in"
 	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"net/http"
 )
 
 var _ in.FindCharacter = (*FindCharacter)(nil)
@@ -12,15 +14,23 @@ var _ in.FindCharacter = (*FindCharacter)(nil)
 type FindCharacter struct {
 	starwarRepository out.StarwarRepository
 	starwarCache      out.StarwarCache
+	characterSource   out.CharacterSource
+	unitOfWork        out.UnitOfWork
 }
 
+// NewFindCharacterUseCase takes an optional character source, when it is nil a character
+// missing from the repository is simply not found.
 func NewFindCharacterUseCase(
 	starwarRepository out.StarwarRepository,
-	starwarCache out.StarwarCache) *FindCharacter {
+	starwarCache out.StarwarCache,
+	characterSource out.CharacterSource,
+	unitOfWork out.UnitOfWork) *FindCharacter {
 
 	return &FindCharacter{
 		starwarRepository: starwarRepository,
 		starwarCache:      starwarCache,
+		characterSource:   characterSource,
+		unitOfWork:        unitOfWork,
 	}
 }
 
@@ -38,6 +48,10 @@ func (f FindCharacter) FindCharacter(characterIdentifier *model.CharacterIdentif
 
 	characterDetail, err := f.starwarRepository.FindCharacterById(characterIdentifier, ctx)
 
+	if err != nil && isNotFound(err) && f.characterSource != nil {
+		characterDetail, err = f.importFromSource(characterIdentifier, err, ctx)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -49,3 +63,67 @@ func (f FindCharacter) FindCharacter(characterIdentifier *model.CharacterIdentif
 
 	return characterDetail, nil
 }
+
+// FindCharacterProjection serves the cached character when there is one, on a miss only the
+// projected fields are read and, being partial, the character is not cached.
+func (f FindCharacter) FindCharacterProjection(characterIdentifier *model.CharacterIdentifier, projection *model.CharacterProjection, ctx context.Context) (*model.CharacterDetail, error) {
+
+	if projection == nil {
+		return f.FindCharacter(characterIdentifier, ctx)
+	}
+
+	findResult, err := f.starwarCache.FindCharacterById(characterIdentifier, ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if findResult != nil {
+		return findResult, nil
+	}
+
+	characterDetail, err := f.starwarRepository.FindCharacterProjection(characterIdentifier, projection, ctx)
+
+	// a character missing from the repository is imported in full, which also caches it.
+	if err != nil && isNotFound(err) && f.characterSource != nil {
+		return f.FindCharacter(characterIdentifier, ctx)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return characterDetail, nil
+}
+
+// importFromSource mirrors a character from the upstream source under the same identifier.
+// An unavailable upstream does not fail the request, the character stays not found.
+func (f FindCharacter) importFromSource(characterIdentifier *model.CharacterIdentifier, notFound error, ctx context.Context) (*model.CharacterDetail, error) {
+
+	character, err := f.characterSource.FindCharacterById(characterIdentifier, ctx)
+
+	if err != nil || character == nil {
+		return nil, notFound
+	}
+
+	var imported *model.CharacterDetail
+	err = f.unitOfWork.WithinTransaction(func(ctx context.Context) error {
+		var importErr error
+		imported, importErr = f.starwarRepository.ImportCharacter(&model.CharacterDetail{
+			Id:        characterIdentifier,
+			Character: character,
+		}, ctx)
+		return importErr
+	}, ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return imported, nil
+}
+
+func isNotFound(err error) bool {
+	statusCode, _ := pkg.GetErrorDetail(err)
+	return statusCode == http.StatusNotFound
+}
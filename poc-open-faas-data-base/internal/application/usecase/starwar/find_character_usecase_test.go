@@ -6,23 +6,29 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"handler/function/internal/application/model"
+	"handler/function/pkg"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
+var characterHeight = 202.0
+var characterMass = 136.0
+var characterBirthYear = -41.9
+
 var character = model.Character{
 	Name:      "Darth Ezequiel",
-	Height:    "202",
-	Mass:      "136",
+	Height:    model.NewMeasure(&characterHeight, model.HeightUnit),
+	Mass:      model.NewMeasure(&characterMass, model.MassUnit),
 	HairColor: "none",
 	SkinColor: "white",
 	EyeColor:  "yellow",
-	BirthYear: "41.9BBY",
+	BirthYear: model.NewBirthYear(&characterBirthYear, "41.9BBY"),
 	Gender:    "male",
 	Homeworld: "https://swapi.dev/api/planets/1/",
-	Created:   "2014-12-10T15:18:20.704000Z",
-	Edited:    "2014-12-20T21:17:50.313000Z",
+	Created:   time.Date(2014, 12, 10, 15, 18, 20, 704000000, time.UTC),
+	Edited:    time.Date(2014, 12, 20, 21, 17, 50, 313000000, time.UTC),
 	Url:       "https://swapi.dev/api/people/4/",
 }
 
@@ -75,6 +81,80 @@ func (s *StarwarRepositoryMock) FindCharacterById(
 	return characterDetail, nil
 }
 
+func (s *StarwarRepositoryMock) UpdateCharacter(
+	character *model.CharacterDetail,
+	ctx context.Context) (*model.CharacterDetail, error) {
+
+	args := s.Called(character, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	characterDetail := firstParameter.(*model.CharacterDetail)
+
+	return characterDetail, nil
+}
+
+func (s *StarwarRepositoryMock) FindCharacterProjection(character *model.CharacterIdentifier, projection *model.CharacterProjection, ctx context.Context) (*model.CharacterDetail, error) {
+	args := s.Called(character, projection, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	characterDetail := firstParameter.(*model.CharacterDetail)
+
+	return characterDetail, nil
+}
+
+func (s *StarwarRepositoryMock) FindCharacters(query *model.CharacterQuery, ctx context.Context) (*model.CharacterPage, error) {
+	args := s.Called(query, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	characterPage := firstParameter.(*model.CharacterPage)
+
+	return characterPage, nil
+}
+
+func (s *StarwarRepositoryMock) ImportCharacter(character *model.CharacterDetail, ctx context.Context) (*model.CharacterDetail, error) {
+	args := s.Called(character, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	characterDetail := firstParameter.(*model.CharacterDetail)
+
+	return characterDetail, nil
+}
+
+type CharacterSourceMock struct {
+	mock.Mock
+}
+
+func (c *CharacterSourceMock) FindCharacterById(character *model.CharacterIdentifier, ctx context.Context) (*model.Character, error) {
+	args := c.Called(character, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	if firstParameter == nil {
+		return nil, nil
+	}
+
+	return firstParameter.(*model.Character), nil
+}
+
 func (s *StarwarCacheMock) SaveCharacter(character *model.CharacterDetail, ctx context.Context) error {
 
 	args := s.Called(character, ctx)
@@ -103,6 +183,17 @@ func (s *StarwarCacheMock) FindCharacterById(characterIdentifier *model.Characte
 	return characterDetail, nil
 }
 
+func (s *StarwarCacheMock) DeleteCharacter(characterIdentifier *model.CharacterIdentifier, ctx context.Context) error {
+
+	args := s.Called(characterIdentifier, ctx)
+
+	if args.Error(0) != nil {
+		return args.Error(0)
+	}
+
+	return nil
+}
+
 func TestFindCharacterCacheOk(t *testing.T) {
 
 	req := httptest.NewRequest(
@@ -121,7 +212,7 @@ func TestFindCharacterCacheOk(t *testing.T) {
 	repositoryMock.On("FindCharacterById", mock.IsType(&model.CharacterIdentifier{}), ctx).
 		Return(&CharacterDetail, nil)
 
-	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock)
+	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock, nil, nil)
 	identifierParam := &model.CharacterIdentifier{
 		Id: 1,
 	}
@@ -153,7 +244,7 @@ func TestFindCharacterCacheNotFound(t *testing.T) {
 	repositoryMock.On("FindCharacterById", mock.IsType(&model.CharacterIdentifier{}), ctx).
 		Return(&CharacterDetail, nil)
 
-	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock)
+	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock, nil, nil)
 	identifierParam := &model.CharacterIdentifier{
 		Id: 1,
 	}
@@ -186,7 +277,7 @@ func TestFindCharacterCacheError(t *testing.T) {
 	repositoryMock.On("FindCharacterById", mock.IsType(&model.CharacterIdentifier{}), ctx).
 		Return(&CharacterDetail, nil)
 
-	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock)
+	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock, nil, nil)
 	identifierParam := &model.CharacterIdentifier{
 		Id: 1,
 	}
@@ -218,7 +309,7 @@ func TestFindCharacterFindRepositoryError(t *testing.T) {
 	repositoryMock.On("FindCharacterById", mock.IsType(&model.CharacterIdentifier{}), ctx).
 		Return(nil, fmt.Errorf("generic error"))
 
-	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock)
+	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock, nil, nil)
 	identifierParam := &model.CharacterIdentifier{
 		Id: 1,
 	}
@@ -250,7 +341,7 @@ func TestFindCharacterSaveRepositoryError(t *testing.T) {
 	repositoryMock.On("FindCharacterById", mock.IsType(&model.CharacterIdentifier{}), ctx).
 		Return(&CharacterDetail, nil)
 
-	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock)
+	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock, nil, nil)
 	identifierParam := &model.CharacterIdentifier{
 		Id: 1,
 	}
@@ -261,3 +352,216 @@ func TestFindCharacterSaveRepositoryError(t *testing.T) {
 	assert.Equal(t, "generic error", err.Error())
 
 }
+
+func TestFindCharacterFromSource(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	identifierParam := &model.CharacterIdentifier{
+		Id: 1,
+	}
+
+	cacheMock := new(StarwarCacheMock)
+	cacheMock.On("FindCharacterById", identifierParam, ctx).
+		Return(nil, nil)
+	cacheMock.On("SaveCharacter", &CharacterDetail, ctx).
+		Return(nil)
+
+	repositoryMock := new(StarwarRepositoryMock)
+	repositoryMock.On("FindCharacterById", identifierParam, ctx).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Character Not Found"})
+	repositoryMock.On("ImportCharacter", &model.CharacterDetail{Id: identifierParam, Character: &character}, ctx).
+		Return(&CharacterDetail, nil)
+
+	sourceMock := new(CharacterSourceMock)
+	sourceMock.On("FindCharacterById", identifierParam, ctx).
+		Return(&character, nil)
+
+	unitOfWorkMock := new(UnitOfWorkMock)
+	unitOfWorkMock.On("WithinTransaction", ctx).Return(nil)
+
+	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock, sourceMock, unitOfWorkMock)
+
+	characterDetail, err := useCase.FindCharacter(identifierParam, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &CharacterDetail, characterDetail)
+	repositoryMock.AssertExpectations(t)
+	cacheMock.AssertExpectations(t)
+	unitOfWorkMock.AssertExpectations(t)
+}
+
+func TestFindCharacterSourceNotFound(t *testing.T) {
+
+	type testCase struct {
+		name         string
+		sourceResult *model.Character
+		sourceError  error
+	}
+
+	testCases := []testCase{
+		{name: "unknown upstream", sourceResult: nil, sourceError: nil},
+		{name: "unavailable upstream", sourceResult: nil, sourceError: pkg.GenericException{StatusCode: http.StatusServiceUnavailable, Msj: "Character source unavailable"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			identifierParam := &model.CharacterIdentifier{
+				Id: 99,
+			}
+			notFound := pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Character Not Found"}
+
+			cacheMock := new(StarwarCacheMock)
+			cacheMock.On("FindCharacterById", identifierParam, ctx).
+				Return(nil, nil)
+
+			repositoryMock := new(StarwarRepositoryMock)
+			repositoryMock.On("FindCharacterById", identifierParam, ctx).
+				Return(nil, notFound)
+
+			sourceMock := new(CharacterSourceMock)
+			sourceMock.On("FindCharacterById", identifierParam, ctx).
+				Return(tc.sourceResult, tc.sourceError)
+
+			useCase := NewFindCharacterUseCase(repositoryMock, cacheMock, sourceMock, new(UnitOfWorkMock))
+
+			characterDetail, err := useCase.FindCharacter(identifierParam, ctx)
+
+			assert.Nil(t, characterDetail)
+			assert.Equal(t, notFound, err)
+			repositoryMock.AssertNotCalled(t, "ImportCharacter", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestFindCharacterSourceSkippedOnRepositoryError(t *testing.T) {
+
+	ctx := context.Background()
+
+	identifierParam := &model.CharacterIdentifier{
+		Id: 1,
+	}
+
+	cacheMock := new(StarwarCacheMock)
+	cacheMock.On("FindCharacterById", identifierParam, ctx).
+		Return(nil, nil)
+
+	repositoryMock := new(StarwarRepositoryMock)
+	repositoryMock.On("FindCharacterById", identifierParam, ctx).
+		Return(nil, fmt.Errorf("generic error"))
+
+	sourceMock := new(CharacterSourceMock)
+
+	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock, sourceMock, new(UnitOfWorkMock))
+
+	characterDetail, err := useCase.FindCharacter(identifierParam, ctx)
+
+	assert.Nil(t, characterDetail)
+	assert.Equal(t, "generic error", err.Error())
+	sourceMock.AssertNotCalled(t, "FindCharacterById", mock.Anything, mock.Anything)
+}
+
+func TestFindCharacterProjectionCacheOk(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1?fields=name",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	identifierParam := &model.CharacterIdentifier{
+		Id: 1,
+	}
+	projection := &model.CharacterProjection{Fields: map[string]bool{"name": true}}
+
+	cacheMock := new(StarwarCacheMock)
+	cacheMock.On("FindCharacterById", identifierParam, ctx).
+		Return(&CharacterDetail, nil)
+
+	repositoryMock := new(StarwarRepositoryMock)
+
+	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock, nil, nil)
+
+	characterDetail, err := useCase.FindCharacterProjection(identifierParam, projection, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &CharacterDetail, characterDetail)
+	repositoryMock.AssertNotCalled(t, "FindCharacterProjection", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFindCharacterProjectionCacheNotFound(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1?fields=name",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	identifierParam := &model.CharacterIdentifier{
+		Id: 1,
+	}
+	projection := &model.CharacterProjection{Fields: map[string]bool{"name": true}}
+	partialDetail := &model.CharacterDetail{Id: identifierParam, Character: &model.Character{Name: character.Name}}
+
+	cacheMock := new(StarwarCacheMock)
+	cacheMock.On("FindCharacterById", identifierParam, ctx).
+		Return(nil, nil)
+
+	repositoryMock := new(StarwarRepositoryMock)
+	repositoryMock.On("FindCharacterProjection", identifierParam, projection, ctx).
+		Return(partialDetail, nil)
+
+	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock, nil, nil)
+
+	characterDetail, err := useCase.FindCharacterProjection(identifierParam, projection, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, partialDetail, characterDetail)
+	cacheMock.AssertNotCalled(t, "SaveCharacter", mock.Anything, mock.Anything)
+	repositoryMock.AssertNotCalled(t, "FindCharacterById", mock.Anything, mock.Anything)
+}
+
+func TestFindCharacterProjectionWithoutFields(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	identifierParam := &model.CharacterIdentifier{
+		Id: 1,
+	}
+
+	cacheMock := new(StarwarCacheMock)
+	cacheMock.On("FindCharacterById", identifierParam, ctx).
+		Return(nil, nil)
+	cacheMock.On("SaveCharacter", &CharacterDetail, ctx).
+		Return(nil)
+
+	repositoryMock := new(StarwarRepositoryMock)
+	repositoryMock.On("FindCharacterById", identifierParam, ctx).
+		Return(&CharacterDetail, nil)
+
+	useCase := NewFindCharacterUseCase(repositoryMock, cacheMock, nil, nil)
+
+	characterDetail, err := useCase.FindCharacterProjection(identifierParam, nil, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &CharacterDetail, characterDetail)
+	cacheMock.AssertExpectations(t)
+}
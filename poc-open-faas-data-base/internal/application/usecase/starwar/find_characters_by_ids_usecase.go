@@ -0,0 +1,55 @@
+package starwar
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+)
+
+var _ in.FindCharactersByIds = (*FindCharactersByIds)(nil)
+
+type FindCharactersByIds struct {
+	starwarRepository out.StarwarRepository
+}
+
+func NewFindCharactersByIdsUseCase(starwarRepository out.StarwarRepository) *FindCharactersByIds {
+	return &FindCharactersByIds{
+		starwarRepository: starwarRepository,
+	}
+}
+
+// FindCharactersByIds reads every character in one repository query, it is meant for batches
+// and skips the cache and the character source that FindCharacter goes through.
+func (f *FindCharactersByIds) FindCharactersByIds(characterIdentifiers []*model.CharacterIdentifier, ctx context.Context) ([]*model.CharacterDetail, error) {
+
+	characters := make([]*model.CharacterDetail, len(characterIdentifiers))
+	if len(characterIdentifiers) == 0 {
+		return characters, nil
+	}
+
+	ids := make([]int, 0, len(characterIdentifiers))
+	seen := map[int]bool{}
+	for _, characterIdentifier := range characterIdentifiers {
+		if !seen[characterIdentifier.Id] {
+			seen[characterIdentifier.Id] = true
+			ids = append(ids, characterIdentifier.Id)
+		}
+	}
+
+	page, err := f.starwarRepository.FindCharacters(&model.CharacterQuery{Ids: ids, Limit: len(ids)}, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[int]*model.CharacterDetail, len(page.Characters))
+	for _, characterDetail := range page.Characters {
+		byId[characterDetail.Id.Id] = characterDetail
+	}
+
+	for i, characterIdentifier := range characterIdentifiers {
+		characters[i] = byId[characterIdentifier.Id]
+	}
+
+	return characters, nil
+}
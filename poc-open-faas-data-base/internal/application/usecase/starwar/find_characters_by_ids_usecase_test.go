@@ -0,0 +1,67 @@
+package starwar
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFindCharactersByIds_FindCharactersByIds(t *testing.T) {
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+
+	ctx := req.Context()
+
+	repositoryMock := new(StarwarRepositoryMock)
+	repositoryMock.On("FindCharacters", mock.MatchedBy(func(q *model.CharacterQuery) bool {
+		return assert.ObjectsAreEqual([]int{CharacterIdentifier.Id, 99}, q.Ids) && q.Limit == 2
+	}), ctx).
+		Return(&model.CharacterPage{Characters: []*model.CharacterDetail{&CharacterDetail}, Total: 1, Limit: 2}, nil)
+
+	useCase := NewFindCharactersByIdsUseCase(repositoryMock)
+
+	characters, err := useCase.FindCharactersByIds([]*model.CharacterIdentifier{
+		{Id: CharacterIdentifier.Id},
+		{Id: 99},
+		{Id: CharacterIdentifier.Id},
+	}, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.CharacterDetail{&CharacterDetail, nil, &CharacterDetail}, characters)
+	repositoryMock.AssertNumberOfCalls(t, "FindCharacters", 1)
+}
+
+func TestFindCharactersByIds_FindCharactersByIdsEmpty(t *testing.T) {
+
+	repositoryMock := new(StarwarRepositoryMock)
+
+	useCase := NewFindCharactersByIdsUseCase(repositoryMock)
+
+	characters, err := useCase.FindCharactersByIds([]*model.CharacterIdentifier{}, httptest.NewRequest(http.MethodPost, "/graphql", nil).Context())
+
+	assert.Nil(t, err)
+	assert.Empty(t, characters)
+	repositoryMock.AssertNotCalled(t, "FindCharacters", mock.Anything, mock.Anything)
+}
+
+func TestFindCharactersByIds_FindCharactersByIdsError(t *testing.T) {
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+
+	ctx := req.Context()
+
+	repositoryMock := new(StarwarRepositoryMock)
+	repositoryMock.On("FindCharacters", mock.IsType(&model.CharacterQuery{}), ctx).
+		Return(nil, fmt.Errorf("connection refused"))
+
+	useCase := NewFindCharactersByIdsUseCase(repositoryMock)
+
+	characters, err := useCase.FindCharactersByIds([]*model.CharacterIdentifier{{Id: 1}}, ctx)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, characters)
+}
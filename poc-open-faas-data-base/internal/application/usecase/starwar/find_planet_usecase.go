@@ -0,0 +1,51 @@
+package starwar
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+)
+
+var _ in.FindPlanet = (*FindPlanet)(nil)
+
+type FindPlanet struct {
+	planetRepository out.PlanetRepository
+	planetCache      out.PlanetCache
+}
+
+func NewFindPlanetUseCase(
+	planetRepository out.PlanetRepository,
+	planetCache out.PlanetCache) *FindPlanet {
+
+	return &FindPlanet{
+		planetRepository: planetRepository,
+		planetCache:      planetCache,
+	}
+}
+
+func (f FindPlanet) FindPlanet(planetIdentifier *model.PlanetIdentifier, ctx context.Context) (*model.PlanetDetail, error) {
+
+	findResult, err := f.planetCache.FindPlanetById(planetIdentifier, ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if findResult != nil {
+		return findResult, nil
+	}
+
+	planetDetail, err := f.planetRepository.FindPlanetById(planetIdentifier, ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	errorSaveCache := f.planetCache.SavePlanet(planetDetail, ctx)
+	if errorSaveCache != nil {
+		return nil, errorSaveCache
+	}
+
+	return planetDetail, nil
+}
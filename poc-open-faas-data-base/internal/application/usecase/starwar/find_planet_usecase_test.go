@@ -0,0 +1,198 @@
+package starwar
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var planet = model.Planet{
+	Name:           "Tatooine",
+	RotationPeriod: "23",
+	OrbitalPeriod:  "304",
+	Diameter:       "10465",
+	Climate:        "arid",
+	Gravity:        "1 standard",
+	Terrain:        "desert",
+	SurfaceWater:   "1",
+	Population:     "200000",
+	Created:        time.Date(2014, 12, 9, 13, 50, 49, 641000000, time.UTC),
+	Edited:         time.Date(2014, 12, 20, 20, 58, 18, 411000000, time.UTC),
+	Url:            "https://swapi.dev/api/planets/1/",
+}
+
+var PlanetIdentifier = model.PlanetIdentifier{
+	Id: 1,
+}
+var PlanetDetail = model.PlanetDetail{
+	Planet:  &planet,
+	Id:      &PlanetIdentifier,
+	Version: 1,
+}
+
+type PlanetRepositoryMock struct {
+	mock.Mock
+}
+
+type PlanetCacheMock struct {
+	mock.Mock
+}
+
+func (p *PlanetRepositoryMock) CreatePlanet(planet *model.Planet, ctx context.Context) (*model.PlanetIdentifier, error) {
+	args := p.Called(planet, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	planetIdentifier := firstParameter.(*model.PlanetIdentifier)
+
+	return planetIdentifier, nil
+}
+
+func (p *PlanetRepositoryMock) FindPlanetById(planet *model.PlanetIdentifier, ctx context.Context) (*model.PlanetDetail, error) {
+	args := p.Called(planet, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	planetDetail := firstParameter.(*model.PlanetDetail)
+
+	return planetDetail, nil
+}
+
+func (p *PlanetRepositoryMock) UpdatePlanet(planet *model.PlanetDetail, ctx context.Context) (*model.PlanetDetail, error) {
+	args := p.Called(planet, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	planetDetail := firstParameter.(*model.PlanetDetail)
+
+	return planetDetail, nil
+}
+
+func (p *PlanetRepositoryMock) DeletePlanet(planet *model.PlanetIdentifier, ctx context.Context) error {
+	args := p.Called(planet, ctx)
+
+	return args.Error(0)
+}
+
+func (p *PlanetCacheMock) SavePlanet(planet *model.PlanetDetail, ctx context.Context) error {
+	args := p.Called(planet, ctx)
+
+	return args.Error(0)
+}
+
+func (p *PlanetCacheMock) FindPlanetById(planetIdentifier *model.PlanetIdentifier, ctx context.Context) (*model.PlanetDetail, error) {
+	args := p.Called(planetIdentifier, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	if firstParameter == nil {
+		return nil, nil
+	}
+	planetDetail := firstParameter.(*model.PlanetDetail)
+
+	return planetDetail, nil
+}
+
+func (p *PlanetCacheMock) DeletePlanet(planetIdentifier *model.PlanetIdentifier, ctx context.Context) error {
+	args := p.Called(planetIdentifier, ctx)
+
+	return args.Error(0)
+}
+
+func TestFindPlanetCacheOk(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	cacheMock := new(PlanetCacheMock)
+	cacheMock.On("FindPlanetById", mock.IsType(&model.PlanetIdentifier{}), ctx).
+		Return(&PlanetDetail, nil)
+
+	repositoryMock := new(PlanetRepositoryMock)
+
+	useCase := NewFindPlanetUseCase(repositoryMock, cacheMock)
+
+	planetDetail, err := useCase.FindPlanet(&PlanetIdentifier, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &PlanetDetail, planetDetail)
+	repositoryMock.AssertNotCalled(t, "FindPlanetById", mock.Anything, mock.Anything)
+}
+
+func TestFindPlanetCacheNotFound(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	cacheMock := new(PlanetCacheMock)
+	cacheMock.On("FindPlanetById", mock.IsType(&model.PlanetIdentifier{}), ctx).
+		Return(nil, nil)
+	cacheMock.On("SavePlanet", mock.IsType(&model.PlanetDetail{}), ctx).
+		Return(nil)
+
+	repositoryMock := new(PlanetRepositoryMock)
+	repositoryMock.On("FindPlanetById", mock.IsType(&model.PlanetIdentifier{}), ctx).
+		Return(&PlanetDetail, nil)
+
+	useCase := NewFindPlanetUseCase(repositoryMock, cacheMock)
+
+	planetDetail, err := useCase.FindPlanet(&PlanetIdentifier, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &PlanetDetail, planetDetail)
+	cacheMock.AssertCalled(t, "SavePlanet", &PlanetDetail, ctx)
+}
+
+func TestFindPlanetRepositoryError(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	cacheMock := new(PlanetCacheMock)
+	cacheMock.On("FindPlanetById", mock.IsType(&model.PlanetIdentifier{}), ctx).
+		Return(nil, nil)
+
+	repositoryMock := new(PlanetRepositoryMock)
+	repositoryMock.On("FindPlanetById", mock.IsType(&model.PlanetIdentifier{}), ctx).
+		Return(nil, fmt.Errorf("generic error"))
+
+	useCase := NewFindPlanetUseCase(repositoryMock, cacheMock)
+
+	planetDetail, err := useCase.FindPlanet(&PlanetIdentifier, ctx)
+
+	assert.Nil(t, planetDetail)
+	assert.Equal(t, "generic error", err.Error())
+}
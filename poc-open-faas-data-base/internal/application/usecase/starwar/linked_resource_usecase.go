@@ -0,0 +1,49 @@
+package starwar
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+)
+
+var _ in.CreateLinkedResource[*model.Film, *model.FilmIdentifier] = (*LinkedResource[*model.Film, *model.FilmIdentifier, *model.FilmDetail])(nil)
+var _ in.FindLinkedResource[*model.FilmIdentifier, *model.FilmDetail] = (*LinkedResource[*model.Film, *model.FilmIdentifier, *model.FilmDetail])(nil)
+var _ in.ListCharacterLinkedResources[*model.FilmDetail] = (*LinkedResource[*model.Film, *model.FilmIdentifier, *model.FilmDetail])(nil)
+
+// LinkedResource creates, finds and lists by character the films, species, vehicles and starships,
+// R is the resource, I its identifier and D its detail.
+type LinkedResource[R model.LinkedResource, I any, D any] struct {
+	repository out.LinkedResourceRepository[R, I, D]
+	clock      out.Clock
+}
+
+func NewLinkedResourceUseCase[R model.LinkedResource, I any, D any](repository out.LinkedResourceRepository[R, I, D], clock out.Clock) *LinkedResource[R, I, D] {
+	return &LinkedResource[R, I, D]{
+		repository: repository,
+		clock:      clock,
+	}
+}
+
+// Create follows the character rules, created is now and edited is created unless they are imported.
+func (l *LinkedResource[R, I, D]) Create(resource R, ctx context.Context) (I, error) {
+	created, edited := resource.Timestamps()
+
+	if created.IsZero() {
+		*created = l.clock.Now()
+	}
+
+	if edited.IsZero() {
+		*edited = *created
+	}
+
+	return l.repository.Create(resource, ctx)
+}
+
+func (l *LinkedResource[R, I, D]) Find(identifier I, ctx context.Context) (D, error) {
+	return l.repository.FindById(identifier, ctx)
+}
+
+func (l *LinkedResource[R, I, D]) ListByCharacter(character *model.CharacterIdentifier, ctx context.Context) ([]D, error) {
+	return l.repository.FindByCharacter(character, ctx)
+}
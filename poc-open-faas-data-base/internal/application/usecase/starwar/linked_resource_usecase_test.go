@@ -0,0 +1,173 @@
+package starwar
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var filmModel = model.Film{
+	Title:        "A New Hope",
+	EpisodeId:    4,
+	OpeningCrawl: "It is a period of civil war.",
+	Director:     "George Lucas",
+	Producer:     "Gary Kurtz, Rick McCallum",
+	ReleaseDate:  "1977-05-25",
+	Created:      time.Date(2014, 12, 10, 14, 23, 31, 880000000, time.UTC),
+	Edited:       time.Date(2014, 12, 20, 19, 49, 45, 256000000, time.UTC),
+	Url:          "https://swapi.dev/api/films/1/",
+}
+
+var FilmIdentifier = model.FilmIdentifier{
+	Id: 1,
+}
+var FilmDetail = model.FilmDetail{
+	Film: &filmModel,
+	Id:   &FilmIdentifier,
+}
+
+type LinkedResourceRepositoryMock[R any, I any, D any] struct {
+	mock.Mock
+}
+
+func (r *LinkedResourceRepositoryMock[R, I, D]) Create(resource R, ctx context.Context) (I, error) {
+	args := r.Called(resource, ctx)
+	identifier, _ := args.Get(0).(I)
+	return identifier, args.Error(1)
+}
+
+func (r *LinkedResourceRepositoryMock[R, I, D]) FindById(identifier I, ctx context.Context) (D, error) {
+	args := r.Called(identifier, ctx)
+	detail, _ := args.Get(0).(D)
+	return detail, args.Error(1)
+}
+
+func (r *LinkedResourceRepositoryMock[R, I, D]) FindByCharacter(character *model.CharacterIdentifier, ctx context.Context) ([]D, error) {
+	args := r.Called(character, ctx)
+	details, _ := args.Get(0).([]D)
+	return details, args.Error(1)
+}
+
+type FilmRepositoryMock = LinkedResourceRepositoryMock[*model.Film, *model.FilmIdentifier, *model.FilmDetail]
+
+func TestLinkedResource_Create(t *testing.T) {
+
+	type testCase struct {
+		name   string
+		create func() (model.LinkedResource, error)
+	}
+
+	testCases := []testCase{
+		{name: "film", create: func() (model.LinkedResource, error) {
+			return createWith(&model.Film{Title: "A New Hope"}, &model.FilmIdentifier{Id: 1}, (*model.FilmDetail)(nil))
+		}},
+		{name: "species", create: func() (model.LinkedResource, error) {
+			return createWith(&model.Species{Name: "Wookie"}, &model.SpeciesIdentifier{Id: 1}, (*model.SpeciesDetail)(nil))
+		}},
+		{name: "vehicle", create: func() (model.LinkedResource, error) {
+			return createWith(&model.Vehicle{Name: "Sand Crawler"}, &model.VehicleIdentifier{Id: 1}, (*model.VehicleDetail)(nil))
+		}},
+		{name: "starship", create: func() (model.LinkedResource, error) {
+			return createWith(&model.Starship{Name: "Death Star"}, &model.StarshipIdentifier{Id: 1}, (*model.StarshipDetail)(nil))
+		}},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			resource, err := test.create()
+
+			created, edited := resource.Timestamps()
+
+			assert.Nil(t, err)
+			assert.Equal(t, clockMock.now, *created)
+			assert.Equal(t, clockMock.now, *edited)
+		})
+	}
+}
+
+// createWith creates a new resource with the use case of its type, D only names the detail type.
+func createWith[R model.LinkedResource, I any, D any](resource R, identifier I, _ D) (model.LinkedResource, error) {
+	ctx := context.Background()
+
+	repositoryMock := new(LinkedResourceRepositoryMock[R, I, D])
+	repositoryMock.On("Create", resource, ctx).Return(identifier, nil)
+
+	_, err := NewLinkedResourceUseCase[R, I, D](repositoryMock, clockMock).Create(resource, ctx)
+	return resource, err
+}
+
+func TestLinkedResource_CreateKeepsImportedTimestamps(t *testing.T) {
+
+	ctx := context.Background()
+
+	importedFilm := filmModel
+
+	repositoryMock := new(FilmRepositoryMock)
+	repositoryMock.On("Create", mock.IsType(&model.Film{}), ctx).
+		Return(&FilmIdentifier, nil)
+
+	useCase := NewLinkedResourceUseCase[*model.Film, *model.FilmIdentifier, *model.FilmDetail](repositoryMock, clockMock)
+
+	filmIdentifier, err := useCase.Create(&importedFilm, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &FilmIdentifier, filmIdentifier)
+	assert.Equal(t, filmModel.Created, importedFilm.Created)
+	assert.Equal(t, filmModel.Edited, importedFilm.Edited)
+}
+
+func TestLinkedResource_Find(t *testing.T) {
+
+	type testCase struct {
+		name       string
+		identifier *model.FilmIdentifier
+		detail     *model.FilmDetail
+		err        error
+	}
+
+	testCases := []testCase{
+		{name: "found", identifier: &FilmIdentifier, detail: &FilmDetail},
+		{name: "not found", identifier: &model.FilmIdentifier{Id: 9}, err: pkg.GenericException{StatusCode: http.StatusNotFound, Msj: "Film Not Found"}},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/films/1", nil).Context()
+
+			repositoryMock := new(FilmRepositoryMock)
+			repositoryMock.On("FindById", test.identifier, ctx).
+				Return(test.detail, test.err)
+
+			useCase := NewLinkedResourceUseCase[*model.Film, *model.FilmIdentifier, *model.FilmDetail](repositoryMock, clockMock)
+
+			filmDetail, err := useCase.Find(test.identifier, ctx)
+
+			assert.Equal(t, test.detail, filmDetail)
+			assert.Equal(t, test.err, err)
+		})
+	}
+}
+
+func TestLinkedResource_ListByCharacter(t *testing.T) {
+
+	ctx := httptest.NewRequest(http.MethodGet, "/api/v1/starwar/characters/1/films", nil).Context()
+
+	characterIdentifier := model.CharacterIdentifier{Id: 1}
+
+	repositoryMock := new(FilmRepositoryMock)
+	repositoryMock.On("FindByCharacter", &characterIdentifier, ctx).
+		Return([]*model.FilmDetail{&FilmDetail}, nil)
+
+	useCase := NewLinkedResourceUseCase[*model.Film, *model.FilmIdentifier, *model.FilmDetail](repositoryMock, clockMock)
+
+	filmDetails, err := useCase.ListByCharacter(&characterIdentifier, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.FilmDetail{&FilmDetail}, filmDetails)
+}
@@ -0,0 +1,38 @@
+package starwar
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+)
+
+var _ in.ListCharacters = (*ListCharacters)(nil)
+
+type ListCharacters struct {
+	starwarRepository out.StarwarRepository
+}
+
+func NewListCharactersUseCase(starwarRepository out.StarwarRepository) *ListCharacters {
+	return &ListCharacters{
+		starwarRepository: starwarRepository,
+	}
+}
+
+// ListCharacters reads straight from the repository, listings are not cached.
+func (l *ListCharacters) ListCharacters(query *model.CharacterQuery, ctx context.Context) (*model.CharacterPage, error) {
+
+	if query.Limit <= 0 {
+		query.Limit = model.DefaultPageLimit
+	}
+
+	if query.Limit > model.MaxPageLimit {
+		query.Limit = model.MaxPageLimit
+	}
+
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+
+	return l.starwarRepository.FindCharacters(query, ctx)
+}
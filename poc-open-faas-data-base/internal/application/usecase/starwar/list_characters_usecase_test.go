@@ -0,0 +1,86 @@
+package starwar
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var CharacterPage = model.CharacterPage{
+	Characters: []*model.CharacterDetail{&CharacterDetail},
+	Total:      1,
+	Limit:      model.DefaultPageLimit,
+}
+
+func TestListCharacters_ListCharacters(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(StarwarRepositoryMock)
+	repositoryMock.On("FindCharacters", mock.IsType(&model.CharacterQuery{}), ctx).
+		Return(&CharacterPage, nil)
+
+	useCase := NewListCharactersUseCase(repositoryMock)
+
+	query := &model.CharacterQuery{}
+	characterPage, err := useCase.ListCharacters(query, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &CharacterPage, characterPage)
+	assert.Equal(t, model.DefaultPageLimit, query.Limit)
+}
+
+func TestListCharacters_ListCharactersMaxLimit(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters?limit=1000",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(StarwarRepositoryMock)
+	repositoryMock.On("FindCharacters", mock.IsType(&model.CharacterQuery{}), ctx).
+		Return(&CharacterPage, nil)
+
+	useCase := NewListCharactersUseCase(repositoryMock)
+
+	query := &model.CharacterQuery{Limit: 1000}
+	_, err := useCase.ListCharacters(query, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.MaxPageLimit, query.Limit)
+}
+
+func TestListCharacters_ListCharactersError(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(StarwarRepositoryMock)
+	repositoryMock.On("FindCharacters", mock.IsType(&model.CharacterQuery{}), ctx).
+		Return(nil, fmt.Errorf("generic error"))
+
+	useCase := NewListCharactersUseCase(repositoryMock)
+
+	characterPage, err := useCase.ListCharacters(&model.CharacterQuery{}, ctx)
+
+	assert.Nil(t, characterPage)
+	assert.Equal(t, "generic error", err.Error())
+}
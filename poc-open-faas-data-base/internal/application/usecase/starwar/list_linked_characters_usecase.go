@@ -0,0 +1,36 @@
+package starwar
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+)
+
+var _ in.ListLinkedCharacters = (*ListLinkedCharacters)(nil)
+
+type ListLinkedCharacters struct {
+	characterLinkRepository out.CharacterLinkRepository
+}
+
+func NewListLinkedCharactersUseCase(characterLinkRepository out.CharacterLinkRepository) *ListLinkedCharacters {
+	return &ListLinkedCharacters{
+		characterLinkRepository: characterLinkRepository,
+	}
+}
+
+func (l ListLinkedCharacters) ListLinkedCharacters(link *model.CharacterLink, ctx context.Context) (*model.CharacterPage, error) {
+	if link.Limit <= 0 {
+		link.Limit = model.DefaultPageLimit
+	}
+
+	if link.Limit > model.MaxPageLimit {
+		link.Limit = model.MaxPageLimit
+	}
+
+	if link.Offset < 0 {
+		link.Offset = 0
+	}
+
+	return l.characterLinkRepository.FindLinkedCharacters(link, ctx)
+}
@@ -0,0 +1,82 @@
+package starwar
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type CharacterLinkRepositoryMock struct {
+	mock.Mock
+}
+
+func (r *CharacterLinkRepositoryMock) FindLinkedCharacters(link *model.CharacterLink, ctx context.Context) (*model.CharacterPage, error) {
+	args := r.Called(link, ctx)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	firstParameter := args.Get(0)
+	characterPage := firstParameter.(*model.CharacterPage)
+
+	return characterPage, nil
+}
+
+func TestListLinkedCharacters_ListLinkedCharacters(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/films/1/characters",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	link := model.CharacterLink{Kind: model.LinkFilms, Id: 1}
+	characterPage := &model.CharacterPage{
+		Characters: []*model.CharacterDetail{&CharacterDetail},
+		Total:      1,
+		Limit:      model.DefaultPageLimit,
+	}
+
+	repositoryMock := new(CharacterLinkRepositoryMock)
+	repositoryMock.On("FindLinkedCharacters", &link, ctx).
+		Return(characterPage, nil)
+
+	useCase := NewListLinkedCharactersUseCase(repositoryMock)
+
+	result, err := useCase.ListLinkedCharacters(&link, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, characterPage, result)
+	assert.Equal(t, model.DefaultPageLimit, link.Limit)
+}
+
+func TestListLinkedCharacters_ListLinkedCharactersMaxLimit(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/films/1/characters?limit=1000&offset=-5",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(CharacterLinkRepositoryMock)
+	repositoryMock.On("FindLinkedCharacters", mock.Anything, ctx).
+		Return(&model.CharacterPage{Limit: model.MaxPageLimit}, nil)
+
+	useCase := NewListLinkedCharactersUseCase(repositoryMock)
+
+	link := &model.CharacterLink{Kind: model.LinkFilms, Id: 1, Limit: 1000, Offset: -5}
+	_, err := useCase.ListLinkedCharacters(link, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.MaxPageLimit, link.Limit)
+	assert.Equal(t, 0, link.Offset)
+}
@@ -0,0 +1,52 @@
+package starwar
+
+import (
+	"context"
+	"fmt"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+	"handler/function/pkg"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+var _ in.SearchCharacters = (*SearchCharacters)(nil)
+
+type SearchCharacters struct {
+	searchRepository out.CharacterSearchRepository
+}
+
+func NewSearchCharactersUseCase(searchRepository out.CharacterSearchRepository) *SearchCharacters {
+	return &SearchCharacters{
+		searchRepository: searchRepository,
+	}
+}
+
+// SearchCharacters reads straight from the repository, like listings searches are not cached.
+func (s *SearchCharacters) SearchCharacters(search *model.CharacterSearch, ctx context.Context) (*model.CharacterSearchResult, error) {
+
+	search.Text = strings.TrimSpace(search.Text)
+
+	if utf8.RuneCountInString(search.Text) < model.MinSearchLength {
+		return nil, pkg.GenericException{
+			StatusCode: http.StatusBadRequest,
+			Msj:        fmt.Sprintf("The search needs at least %d characters\n", model.MinSearchLength),
+		}
+	}
+
+	if search.Limit <= 0 {
+		search.Limit = model.DefaultPageLimit
+	}
+
+	if search.Limit > model.MaxPageLimit {
+		search.Limit = model.MaxPageLimit
+	}
+
+	if search.Offset < 0 {
+		search.Offset = 0
+	}
+
+	return s.searchRepository.SearchCharacters(search, ctx)
+}
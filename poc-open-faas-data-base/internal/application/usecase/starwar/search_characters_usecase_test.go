@@ -0,0 +1,105 @@
+package starwar
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type CharacterSearchRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *CharacterSearchRepositoryMock) SearchCharacters(search *model.CharacterSearch, ctx context.Context) (*model.CharacterSearchResult, error) {
+	args := m.Called(search, ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.CharacterSearchResult), args.Error(1)
+}
+
+var CharacterSearchResult = model.CharacterSearchResult{
+	Matches: []*model.CharacterMatch{
+		{
+			Character:  &CharacterDetail,
+			Score:      0.67,
+			Highlights: map[string]string{"name": "Luke <em>Skywalker</em>"},
+		},
+	},
+	Total: 1,
+	Limit: model.DefaultPageLimit,
+}
+
+func TestSearchCharacters_SearchCharacters(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/search?q=skywlker",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(CharacterSearchRepositoryMock)
+	repositoryMock.On("SearchCharacters", mock.IsType(&model.CharacterSearch{}), ctx).
+		Return(&CharacterSearchResult, nil)
+
+	useCase := NewSearchCharactersUseCase(repositoryMock)
+
+	search := &model.CharacterSearch{Text: "  skywlker ", Limit: 1000}
+	result, err := useCase.SearchCharacters(search, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &CharacterSearchResult, result)
+	assert.Equal(t, "skywlker", search.Text)
+	assert.Equal(t, model.MaxPageLimit, search.Limit)
+}
+
+func TestSearchCharacters_SearchCharactersTooShort(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/search?q=l",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(CharacterSearchRepositoryMock)
+
+	useCase := NewSearchCharactersUseCase(repositoryMock)
+
+	_, err := useCase.SearchCharacters(&model.CharacterSearch{Text: " l "}, ctx)
+
+	statusCode, _ := pkg.GetErrorDetail(err)
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	repositoryMock.AssertNotCalled(t, "SearchCharacters", mock.Anything, mock.Anything)
+}
+
+func TestSearchCharacters_SearchCharactersError(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodGet,
+		"/api/v1/starwar/characters/search?q=luke",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(CharacterSearchRepositoryMock)
+	repositoryMock.On("SearchCharacters", mock.IsType(&model.CharacterSearch{}), ctx).
+		Return(nil, fmt.Errorf("generic error"))
+
+	useCase := NewSearchCharactersUseCase(repositoryMock)
+
+	result, err := useCase.SearchCharacters(&model.CharacterSearch{Text: "luke"}, ctx)
+
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,62 @@
+package starwar
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+	"log"
+)
+
+var _ in.UpdateCharacter = (*UpdateCharacter)(nil)
+
+type UpdateCharacter struct {
+	starwarRepository out.StarwarRepository
+	starwarCache      out.StarwarCache
+	unitOfWork        out.UnitOfWork
+	clock             out.Clock
+}
+
+func NewUpdateCharacterUseCase(
+	starwarRepository out.StarwarRepository,
+	starwarCache out.StarwarCache,
+	unitOfWork out.UnitOfWork,
+	clock out.Clock) *UpdateCharacter {
+
+	return &UpdateCharacter{
+		starwarRepository: starwarRepository,
+		starwarCache:      starwarCache,
+		unitOfWork:        unitOfWork,
+		clock:             clock,
+	}
+}
+
+// UpdateCharacter only succeeds when character.Version still matches the stored version,
+// the cached copy is evicted afterwards so the next read gets the new version.
+// The update is committed by then, an eviction that fails is logged and the cached copy expires with its ttl.
+func (u *UpdateCharacter) UpdateCharacter(character *model.CharacterDetail, ctx context.Context) (*model.CharacterDetail, error) {
+	var characterDetail *model.CharacterDetail
+
+	character.Character.Edited = u.clock.Now()
+
+	err := u.unitOfWork.WithinTransaction(func(ctx context.Context) error {
+		updateResult, err := u.starwarRepository.UpdateCharacter(character, ctx)
+		if err != nil {
+			return err
+		}
+
+		characterDetail = updateResult
+		return nil
+	}, ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	errorDeleteCache := u.starwarCache.DeleteCharacter(character.Id, ctx)
+	if errorDeleteCache != nil {
+		log.Printf("Error evicting updated character %d from cache %s\n", character.Id.Id, errorDeleteCache.Error())
+	}
+
+	return characterDetail, nil
+}
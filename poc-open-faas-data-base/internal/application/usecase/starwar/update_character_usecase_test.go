@@ -0,0 +1,110 @@
+package starwar
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var UpdatedCharacterDetail = model.CharacterDetail{
+	Character: &character,
+	Id:        &CharacterIdentifier,
+	Version:   2,
+}
+
+func TestUpdateCharacter_UpdateCharacter(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(StarwarRepositoryMock)
+	repositoryMock.On("UpdateCharacter", mock.IsType(&model.CharacterDetail{}), ctx).
+		Return(&UpdatedCharacterDetail, nil)
+
+	cacheMock := new(StarwarCacheMock)
+	cacheMock.On("DeleteCharacter", mock.IsType(&model.CharacterIdentifier{}), ctx).
+		Return(nil)
+
+	unitOfWorkMock := new(UnitOfWorkMock)
+	unitOfWorkMock.On("WithinTransaction", ctx).Return(nil)
+
+	useCase := NewUpdateCharacterUseCase(repositoryMock, cacheMock, unitOfWorkMock, clockMock)
+
+	updatedCharacter := character
+	characterDetail, err := useCase.UpdateCharacter(&model.CharacterDetail{Id: &CharacterIdentifier, Character: &updatedCharacter, Version: 1}, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &UpdatedCharacterDetail, characterDetail)
+	assert.Equal(t, clockMock.now, updatedCharacter.Edited)
+	cacheMock.AssertCalled(t, "DeleteCharacter", &CharacterIdentifier, ctx)
+}
+
+func TestUpdateCharacter_UpdateCharacterVersionMismatch(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(StarwarRepositoryMock)
+	repositoryMock.On("UpdateCharacter", mock.IsType(&model.CharacterDetail{}), ctx).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusPreconditionFailed, Msj: "modified"})
+
+	cacheMock := new(StarwarCacheMock)
+
+	unitOfWorkMock := new(UnitOfWorkMock)
+	unitOfWorkMock.On("WithinTransaction", ctx).Return(nil)
+
+	useCase := NewUpdateCharacterUseCase(repositoryMock, cacheMock, unitOfWorkMock, clockMock)
+
+	updatedCharacter := character
+	characterDetail, err := useCase.UpdateCharacter(&model.CharacterDetail{Id: &CharacterIdentifier, Character: &updatedCharacter, Version: 1}, ctx)
+
+	statusCode, _ := pkg.GetErrorDetail(err)
+
+	assert.Nil(t, characterDetail)
+	assert.Equal(t, http.StatusPreconditionFailed, statusCode)
+	cacheMock.AssertNotCalled(t, "DeleteCharacter", mock.Anything, mock.Anything)
+}
+
+func TestUpdateCharacter_UpdateCharacterCacheError(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/api/v1/starwar/characters/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	repositoryMock := new(StarwarRepositoryMock)
+	repositoryMock.On("UpdateCharacter", mock.IsType(&model.CharacterDetail{}), ctx).
+		Return(&UpdatedCharacterDetail, nil)
+
+	cacheMock := new(StarwarCacheMock)
+	cacheMock.On("DeleteCharacter", mock.IsType(&model.CharacterIdentifier{}), ctx).
+		Return(pkg.GenericException{StatusCode: http.StatusInternalServerError, Msj: "cache error"})
+
+	unitOfWorkMock := new(UnitOfWorkMock)
+	unitOfWorkMock.On("WithinTransaction", ctx).Return(nil)
+
+	useCase := NewUpdateCharacterUseCase(repositoryMock, cacheMock, unitOfWorkMock, clockMock)
+
+	updatedCharacter := character
+	characterDetail, err := useCase.UpdateCharacter(&model.CharacterDetail{Id: &CharacterIdentifier, Character: &updatedCharacter, Version: 1}, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &UpdatedCharacterDetail, characterDetail)
+}
@@ -0,0 +1,48 @@
+package starwar
+
+import (
+	"context"
+	"handler/function/internal/application/model"
+	"handler/function/internal/application/port/in"
+	"handler/function/internal/application/port/out"
+	"log"
+)
+
+var _ in.UpdatePlanet = (*UpdatePlanet)(nil)
+
+type UpdatePlanet struct {
+	planetRepository out.PlanetRepository
+	planetCache      out.PlanetCache
+	clock            out.Clock
+}
+
+func NewUpdatePlanetUseCase(
+	planetRepository out.PlanetRepository,
+	planetCache out.PlanetCache,
+	clock out.Clock) *UpdatePlanet {
+
+	return &UpdatePlanet{
+		planetRepository: planetRepository,
+		planetCache:      planetCache,
+		clock:            clock,
+	}
+}
+
+// UpdatePlanet evicts the cached copy once the update is stored, an eviction that fails is logged
+// and the cached copy expires with its ttl.
+func (u *UpdatePlanet) UpdatePlanet(planet *model.PlanetDetail, ctx context.Context) (*model.PlanetDetail, error) {
+
+	planet.Planet.Edited = u.clock.Now()
+
+	planetDetail, err := u.planetRepository.UpdatePlanet(planet, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	errorDeleteCache := u.planetCache.DeletePlanet(planet.Id, ctx)
+	if errorDeleteCache != nil {
+		log.Printf("Error evicting updated planet %d from cache %s\n", planet.Id.Id, errorDeleteCache.Error())
+	}
+
+	return planetDetail, nil
+}
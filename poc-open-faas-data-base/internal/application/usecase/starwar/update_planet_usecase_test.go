@@ -0,0 +1,98 @@
+package starwar
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"handler/function/internal/application/model"
+	"handler/function/pkg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdatePlanet_UpdatePlanet(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	updatedPlanet := planet
+
+	repositoryMock := new(PlanetRepositoryMock)
+	repositoryMock.On("UpdatePlanet", mock.IsType(&model.PlanetDetail{}), ctx).
+		Return(&PlanetDetail, nil)
+
+	cacheMock := new(PlanetCacheMock)
+	cacheMock.On("DeletePlanet", mock.IsType(&model.PlanetIdentifier{}), ctx).
+		Return(nil)
+
+	useCase := NewUpdatePlanetUseCase(repositoryMock, cacheMock, clockMock)
+
+	planetDetail, err := useCase.UpdatePlanet(&model.PlanetDetail{Id: &PlanetIdentifier, Planet: &updatedPlanet, Version: 1}, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &PlanetDetail, planetDetail)
+	assert.Equal(t, clockMock.now, updatedPlanet.Edited)
+	cacheMock.AssertCalled(t, "DeletePlanet", &PlanetIdentifier, ctx)
+}
+
+func TestUpdatePlanet_UpdatePlanetVersionMismatch(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	updatedPlanet := planet
+
+	repositoryMock := new(PlanetRepositoryMock)
+	repositoryMock.On("UpdatePlanet", mock.IsType(&model.PlanetDetail{}), ctx).
+		Return(nil, pkg.GenericException{StatusCode: http.StatusPreconditionFailed, Msj: "modified"})
+
+	cacheMock := new(PlanetCacheMock)
+
+	useCase := NewUpdatePlanetUseCase(repositoryMock, cacheMock, clockMock)
+
+	planetDetail, err := useCase.UpdatePlanet(&model.PlanetDetail{Id: &PlanetIdentifier, Planet: &updatedPlanet, Version: 1}, ctx)
+
+	statusCode, _ := pkg.GetErrorDetail(err)
+
+	assert.Nil(t, planetDetail)
+	assert.Equal(t, http.StatusPreconditionFailed, statusCode)
+	cacheMock.AssertNotCalled(t, "DeletePlanet", mock.Anything, mock.Anything)
+}
+
+func TestUpdatePlanet_UpdatePlanetCacheError(t *testing.T) {
+
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/api/v1/starwar/planets/1",
+		nil,
+	)
+
+	ctx := req.Context()
+
+	updatedPlanet := planet
+
+	repositoryMock := new(PlanetRepositoryMock)
+	repositoryMock.On("UpdatePlanet", mock.IsType(&model.PlanetDetail{}), ctx).
+		Return(&PlanetDetail, nil)
+
+	cacheMock := new(PlanetCacheMock)
+	cacheMock.On("DeletePlanet", mock.IsType(&model.PlanetIdentifier{}), ctx).
+		Return(pkg.GenericException{StatusCode: http.StatusInternalServerError, Msj: "cache error"})
+
+	useCase := NewUpdatePlanetUseCase(repositoryMock, cacheMock, clockMock)
+
+	planetDetail, err := useCase.UpdatePlanet(&model.PlanetDetail{Id: &PlanetIdentifier, Planet: &updatedPlanet, Version: 1}, ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &PlanetDetail, planetDetail)
+}
@@ -0,0 +1,242 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const redacted = "******"
+
+// Secret is a value that is redacted whenever it is printed, Value returns it.
+type Secret string
+
+func (s Secret) Value() string {
+	return string(s)
+}
+
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+func (s Secret) GoString() string {
+	return strconv.Quote(s.String())
+}
+
+// Config is the configuration of the function, every field is read from the key of its config tag.
+type Config struct {
+	Database        DatabaseConfig
+	Cache           CacheConfig
+	CharacterSource CharacterSourceConfig
+	Grpc            GrpcConfig
+	Openapi         OpenapiConfig
+	Tracing         TracingConfig
+	Import          ImportConfig
+	Swapi           SwapiConfig
+}
+
+type DatabaseConfig struct {
+	Url               Secret        `config:"database_url"`
+	MaxConns          int           `config:"database_max_conns"`
+	MinConns          int           `config:"database_min_conns"`
+	MaxConnLifetime   time.Duration `config:"database_max_conn_lifetime"`
+	MaxConnIdleTime   time.Duration `config:"database_max_conn_idle_time"`
+	HealthCheckPeriod time.Duration `config:"database_health_check_period"`
+}
+
+type CacheConfig struct {
+	Url      string        `config:"cache_url"`
+	Password Secret        `config:"cache_password"`
+	Ttl      time.Duration `config:"cache_ttl" default:"60s"`
+	// StatsTtl keeps the character ttl when it is not set.
+	StatsTtl time.Duration `config:"stats_cache_ttl"`
+}
+
+type CharacterSourceConfig struct {
+	Enabled          bool          `config:"character_source_enabled"`
+	Url              string        `config:"character_source_url" default:"https://swapi.dev/api"`
+	Timeout          time.Duration `config:"character_source_timeout" default:"2s"`
+	FailureThreshold int           `config:"character_source_failure_threshold" default:"5"`
+	OpenTimeout      time.Duration `config:"character_source_open_timeout" default:"30s"`
+}
+
+type GrpcConfig struct {
+	// Port 0 does not serve the gRPC api.
+	Port int `config:"grpc_port"`
+}
+
+type OpenapiConfig struct {
+	ValidateRequests  bool `config:"openapi_validate_requests"`
+	ValidateResponses bool `config:"openapi_validate_responses"`
+}
+
+// ImportConfig allows ?mode=import to the requests that send Token in the X-Import-Token header,
+// without a token the import mode is disabled and the client timestamps are always ignored.
+type ImportConfig struct {
+	Token Secret `config:"import_token"`
+}
+
+// SwapiConfig is where the links of /api/people point. BaseUrl is the public url of the function,
+// e.g. https://gateway.example.com/function/poc-open-faas-data-base, without it the links use the request host,
+// taken from the X-Forwarded headers only when the gateway in front of the function is trusted to set them.
+type SwapiConfig struct {
+	BaseUrl               string `config:"swapi_base_url"`
+	TrustForwardedHeaders bool   `config:"swapi_trust_forwarded_headers"`
+}
+
+// TracingConfig exports the spans to stdout for local runs, or to an OpenTelemetry collector over OTLP http.
+type TracingConfig struct {
+	Exporter     string `config:"tracing_exporter" default:"none"`
+	ServiceName  string `config:"tracing_service_name" default:"poc-open-faas-data-base"`
+	OtlpEndpoint string `config:"tracing_otlp_endpoint" default:"localhost:4318"`
+	OtlpInsecure bool   `config:"tracing_otlp_insecure"`
+}
+
+// Startup modes, an eager function initializes when it is deployed and a lazy one with its first request or readiness probe.
+const (
+	StartupEager = "eager"
+	StartupLazy  = "lazy"
+)
+
+// LifecycleConfig is how the function initializes, requests wait up to WaitTimeout for it,
+// and how long the requests in flight are drained when it shuts down.
+type LifecycleConfig struct {
+	Mode                string        `config:"startup_mode" default:"eager"`
+	WaitTimeout         time.Duration `config:"startup_wait_timeout" default:"5s"`
+	RetryBackoff        time.Duration `config:"startup_retry_backoff" default:"1s"`
+	RetryMaxBackoff     time.Duration `config:"startup_retry_max_backoff" default:"30s"`
+	ShutdownGracePeriod time.Duration `config:"shutdown_grace_period" default:"20s"`
+}
+
+// ServerConfig is the http server of the standalone mode, outside OpenFaaS the watchdog does not serve the function.
+type ServerConfig struct {
+	Address      string        `config:"server_address" default:":8080"`
+	TlsCertFile  string        `config:"server_tls_cert_file"`
+	TlsKeyFile   string        `config:"server_tls_key_file"`
+	ReadTimeout  time.Duration `config:"server_read_timeout" default:"10s"`
+	WriteTimeout time.Duration `config:"server_write_timeout" default:"30s"`
+	IdleTimeout  time.Duration `config:"server_idle_timeout" default:"60s"`
+}
+
+// TlsEnabled is true when a certificate is configured, the server answers https then.
+func (c *ServerConfig) TlsEnabled() bool {
+	return c.TlsCertFile != ""
+}
+
+// ValidationError has every problem of the configuration, so all of them are fixed at once.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid configuration: %s", strings.Join(e.Problems, "; "))
+}
+
+func (e *ValidationError) add(format string, args ...any) {
+	e.Problems = append(e.Problems, fmt.Sprintf(format, args...))
+}
+
+func (c *Config) validate(problems *ValidationError) {
+	if c.Database.Url == "" {
+		problems.add("database_url is required")
+	}
+
+	if c.Database.MaxConns < 0 {
+		problems.add("database_max_conns must not be negative")
+	}
+
+	if c.Database.MinConns < 0 {
+		problems.add("database_min_conns must not be negative")
+	}
+
+	if c.Database.MaxConns > 0 && c.Database.MinConns > c.Database.MaxConns {
+		problems.add("database_min_conns must not be greater than database_max_conns")
+	}
+
+	if c.Cache.Url == "" {
+		problems.add("cache_url is required")
+	}
+
+	if c.Cache.Ttl <= 0 {
+		problems.add("cache_ttl must be positive")
+	}
+
+	if c.CharacterSource.Enabled {
+		sourceUrl, err := url.Parse(c.CharacterSource.Url)
+		if err != nil || (sourceUrl.Scheme != "http" && sourceUrl.Scheme != "https") || sourceUrl.Host == "" {
+			problems.add("character_source_url must be an http url: %s", c.CharacterSource.Url)
+		}
+	}
+
+	if c.Swapi.BaseUrl != "" {
+		baseUrl, err := url.Parse(c.Swapi.BaseUrl)
+		if err != nil || (baseUrl.Scheme != "http" && baseUrl.Scheme != "https") || baseUrl.Host == "" {
+			problems.add("swapi_base_url must be an http url: %s", c.Swapi.BaseUrl)
+		}
+	}
+
+	if c.CharacterSource.FailureThreshold < 0 {
+		problems.add("character_source_failure_threshold must not be negative")
+	}
+
+	if c.Grpc.Port < 0 || c.Grpc.Port > 65535 {
+		problems.add("grpc_port must be between 0 and 65535: %d", c.Grpc.Port)
+	}
+
+	if c.Tracing.Exporter != "none" && c.Tracing.Exporter != "stdout" && c.Tracing.Exporter != "otlp" {
+		problems.add("tracing_exporter must be none, stdout or otlp: %s", c.Tracing.Exporter)
+	}
+}
+
+func (c *LifecycleConfig) validate(problems *ValidationError) {
+	if c.Mode != StartupEager && c.Mode != StartupLazy {
+		problems.add("startup_mode must be %s or %s: %s", StartupEager, StartupLazy, c.Mode)
+	}
+
+	if c.RetryBackoff <= 0 {
+		problems.add("startup_retry_backoff must be positive")
+	}
+
+	if c.RetryMaxBackoff < c.RetryBackoff {
+		problems.add("startup_retry_max_backoff must not be less than startup_retry_backoff")
+	}
+}
+
+func (c *ServerConfig) validate(problems *ValidationError) {
+	if c.Address == "" {
+		problems.add("server_address is required")
+	}
+
+	if (c.TlsCertFile == "") != (c.TlsKeyFile == "") {
+		problems.add("server_tls_cert_file and server_tls_key_file must be set together")
+	}
+}
+
+// String prints every key with its value, as they are set in the environment.
+func (c Config) String() string {
+	values := []string{}
+	visitFields(reflect.ValueOf(&c).Elem(), func(field reflect.StructField, value reflect.Value) {
+		values = append(values, fmt.Sprintf("%s=%v", field.Tag.Get("config"), value.Interface()))
+	})
+	return strings.Join(values, " ")
+}
+
+// visitFields calls visit for every field with a config tag, nested structs included.
+func visitFields(v reflect.Value, visit func(field reflect.StructField, value reflect.Value)) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Type.Kind() == reflect.Struct {
+			visitFields(v.Field(i), visit)
+			continue
+		}
+		if field.Tag.Get("config") != "" {
+			visit(field, v.Field(i))
+		}
+	}
+}
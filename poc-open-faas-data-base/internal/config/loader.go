@@ -0,0 +1,220 @@
+package config
+
+import (
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultSecretsDir is where OpenFaaS mounts the secrets of the function.
+const DefaultSecretsDir = "/var/openfaas/secrets"
+
+// Sources are read in order, each one overrides the previous: the defaults, the file, the environment and the secrets.
+type Sources struct {
+	// File is an optional yaml file with the same keys as the environment.
+	File string
+	// Env looks up an environment variable.
+	Env func(name string) (string, bool)
+	// SecretsDir has a file per secret, named after the key with dashes or underscores.
+	SecretsDir string
+}
+
+// DefaultSources reads the file of the config_file variable, the process environment and the OpenFaaS secrets.
+func DefaultSources() *Sources {
+	return &Sources{
+		File:       os.Getenv("config_file"),
+		Env:        os.LookupEnv,
+		SecretsDir: DefaultSecretsDir,
+	}
+}
+
+// Load builds the configuration, a ValidationError lists every invalid or missing value.
+func Load(s *Sources) (*Config, error) {
+	config := &Config{}
+
+	problems, err := load(config, s)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Cache.StatsTtl == 0 {
+		config.Cache.StatsTtl = config.Cache.Ttl
+	}
+
+	config.validate(problems)
+
+	if len(problems.Problems) > 0 {
+		return nil, problems
+	}
+
+	return config, nil
+}
+
+// LoadLifecycle builds the lifecycle configuration, it is read apart because it is needed before the function is initialized.
+func LoadLifecycle(s *Sources) (*LifecycleConfig, error) {
+	lifecycle := &LifecycleConfig{}
+
+	problems, err := load(lifecycle, s)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycle.validate(problems)
+
+	if len(problems.Problems) > 0 {
+		return nil, problems
+	}
+
+	return lifecycle, nil
+}
+
+// LoadServer builds the configuration of the standalone server.
+func LoadServer(s *Sources) (*ServerConfig, error) {
+	server := &ServerConfig{}
+
+	problems, err := load(server, s)
+	if err != nil {
+		return nil, err
+	}
+
+	server.validate(problems)
+
+	if len(problems.Problems) > 0 {
+		return nil, problems
+	}
+
+	return server, nil
+}
+
+// load sets every field of target, a pointer to a struct, and returns the values that could not be parsed.
+func load(target any, s *Sources) (*ValidationError, error) {
+	fileValues, err := readFile(s.File)
+	if err != nil {
+		return nil, err
+	}
+
+	problems := &ValidationError{}
+
+	visitFields(reflect.ValueOf(target).Elem(), func(field reflect.StructField, value reflect.Value) {
+		key := field.Tag.Get("config")
+
+		text, found := lookup(key, field.Tag.Get("default"), fileValues, s)
+		if !found {
+			return
+		}
+
+		if err := setValue(value, text); err != nil {
+			problems.add("invalid %s: %s", key, err.Error())
+		}
+	})
+
+	return problems, nil
+}
+
+func lookup(key string, defaultValue string, fileValues map[string]string, s *Sources) (string, bool) {
+	text, found := defaultValue, defaultValue != ""
+
+	if value, ok := fileValues[key]; ok {
+		text, found = value, true
+	}
+
+	if s.Env != nil {
+		if value, ok := s.Env(key); ok && value != "" {
+			text, found = value, true
+		}
+	}
+
+	if value, ok := readSecret(s.SecretsDir, key); ok {
+		text, found = value, true
+	}
+
+	return text, found
+}
+
+func readFile(file string) (map[string]string, error) {
+	values := map[string]string{}
+	if file == "" {
+		return values, nil
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %s", err.Error())
+	}
+
+	if err := yaml.Unmarshal(content, &values); err != nil {
+		return nil, fmt.Errorf("parsing config file %s: %s", file, err.Error())
+	}
+
+	return values, nil
+}
+
+// readSecret accepts database-url and database_url, kubernetes does not allow underscores in secret names.
+func readSecret(dir string, key string) (string, bool) {
+	if dir == "" {
+		return "", false
+	}
+
+	for _, name := range []string{strings.ReplaceAll(key, "_", "-"), key} {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err == nil {
+			return strings.TrimSpace(string(content)), true
+		}
+	}
+
+	return "", false
+}
+
+func setValue(value reflect.Value, text string) error {
+	switch value.Interface().(type) {
+	case time.Duration:
+		duration, err := parseDuration(text)
+		if err != nil {
+			return err
+		}
+		value.SetInt(int64(duration))
+	case int:
+		number, err := strconv.Atoi(text)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", text)
+		}
+		value.SetInt(int64(number))
+	case bool:
+		flag, err := strconv.ParseBool(text)
+		if err != nil {
+			return fmt.Errorf("%q is not a boolean", text)
+		}
+		value.SetBool(flag)
+	case string, Secret:
+		value.SetString(text)
+	default:
+		return fmt.Errorf("unsupported type %s", value.Type())
+	}
+	return nil
+}
+
+// parseDuration reads go durations such as 60s or 5m, a plain number is milliseconds as the variables always were.
+func parseDuration(text string) (time.Duration, error) {
+	if milliseconds, err := strconv.Atoi(text); err == nil {
+		if milliseconds < 0 {
+			return 0, fmt.Errorf("%q must not be negative", text)
+		}
+		return time.Duration(milliseconds) * time.Millisecond, nil
+	}
+
+	duration, err := time.ParseDuration(text)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a duration", text)
+	}
+
+	if duration < 0 {
+		return 0, fmt.Errorf("%q must not be negative", text)
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,140 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// minRetryAfter is the least a client not served during the initialization is asked to wait.
+const minRetryAfter = time.Second
+
+type Options struct {
+	// WaitTimeout is how long a request waits for the initialization before it is answered 503.
+	WaitTimeout time.Duration
+	// RetryBackoff is the wait after the first failed initialization, it doubles up to RetryMaxBackoff.
+	RetryBackoff    time.Duration
+	RetryMaxBackoff time.Duration
+}
+
+// NotReadyError is returned to the requests that arrive before the initialization completed.
+type NotReadyError struct {
+	RetryAfter time.Duration
+	Cause      error
+}
+
+func (e *NotReadyError) Error() string {
+	if e.Cause == nil {
+		return "the function is initializing"
+	}
+	return fmt.Sprintf("the function failed to initialize: %s", e.Cause.Error())
+}
+
+// Lifecycle runs the initialization of the function once, until it succeeds.
+// It is started eagerly with Start or lazily by the first request that calls Wait.
+type Lifecycle struct {
+	initialize func(ctx context.Context) error
+	options    *Options
+	ctx        context.Context
+	once       sync.Once
+	ready      chan struct{}
+	mutex      sync.RWMutex
+	lastErr    error
+	retryAt    time.Time
+}
+
+// New keeps ctx for the initialization, a lazy one included, canceling it stops the retries.
+func New(initialize func(ctx context.Context) error, o *Options, ctx context.Context) *Lifecycle {
+	return &Lifecycle{
+		initialize: initialize,
+		options:    o,
+		ctx:        ctx,
+		ready:      make(chan struct{}),
+	}
+}
+
+// Start runs the initialization in the background, a failed one is retried with backoff until the
+// context of the lifecycle is done. Only the first call starts it.
+func (l *Lifecycle) Start() {
+	l.once.Do(func() {
+		go l.run(l.ctx)
+	})
+}
+
+func (l *Lifecycle) run(ctx context.Context) {
+	backoff := l.options.RetryBackoff
+
+	for attempt := 1; ; attempt++ {
+		err := l.initialize(ctx)
+		if err == nil {
+			log.Printf("Function initialized after %d attempts\n", attempt)
+			close(l.ready)
+			return
+		}
+
+		log.Printf("Error initializing function, attempt %d, retrying in %s: %s\n", attempt, backoff, err.Error())
+
+		l.mutex.Lock()
+		l.lastErr = err
+		l.retryAt = time.Now().Add(backoff)
+		l.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			log.Printf("Function initialization stopped %s\n", ctx.Err().Error())
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > l.options.RetryMaxBackoff {
+			backoff = l.options.RetryMaxBackoff
+		}
+	}
+}
+
+func (l *Lifecycle) Ready() bool {
+	select {
+	case <-l.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+// Wait returns once the function is initialized, or a NotReadyError after the wait timeout.
+// The first call starts the initialization when it was not started eagerly.
+func (l *Lifecycle) Wait(ctx context.Context) error {
+	if l.Ready() {
+		return nil
+	}
+
+	// the initialization outlives the request that started it.
+	l.Start()
+
+	timer := time.NewTimer(l.options.WaitTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-l.ready:
+		return nil
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	return l.notReady()
+}
+
+func (l *Lifecycle) notReady() *NotReadyError {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	retryAfter := time.Until(l.retryAt)
+	if retryAfter < minRetryAfter {
+		retryAfter = minRetryAfter
+	}
+
+	return &NotReadyError{RetryAfter: retryAfter, Cause: l.lastErr}
+}
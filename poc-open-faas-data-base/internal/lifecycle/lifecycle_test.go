@@ -0,0 +1,124 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func options(waitTimeout time.Duration) *Options {
+	return &Options{WaitTimeout: waitTimeout, RetryBackoff: 10 * time.Millisecond, RetryMaxBackoff: 20 * time.Millisecond}
+}
+
+func TestWaitInitializesLazilyOnce(t *testing.T) {
+	var attempts int32
+	lifecycle := New(func(ctx context.Context) error {
+		atomic.AddInt32(&attempts, 1)
+		return nil
+	}, options(time.Second), context.Background())
+
+	assert.False(t, lifecycle.Ready())
+
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, lifecycle.Wait(context.Background()))
+	}
+
+	assert.True(t, lifecycle.Ready())
+	assert.EqualValues(t, 1, atomic.LoadInt32(&attempts))
+}
+
+func TestStartRetriesFailedInitialization(t *testing.T) {
+	var attempts int32
+	lifecycle := New(func(ctx context.Context) error {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			return errors.New("database unavailable")
+		}
+		return nil
+	}, options(time.Second), context.Background())
+
+	lifecycle.Start()
+	lifecycle.Start()
+
+	assert.Nil(t, lifecycle.Wait(context.Background()))
+	assert.EqualValues(t, 3, atomic.LoadInt32(&attempts))
+}
+
+func TestLazyInitializationStopsWithTheContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var attempts int32
+	lifecycle := New(func(ctx context.Context) error {
+		atomic.AddInt32(&attempts, 1)
+		cancel()
+		return errors.New("database unavailable")
+	}, options(50*time.Millisecond), ctx)
+
+	err := lifecycle.Wait(context.Background())
+
+	_, ok := err.(*NotReadyError)
+	assert.True(t, ok)
+
+	time.Sleep(50 * time.Millisecond)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&attempts))
+}
+
+func TestWaitTimesOutWithTheCause(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lifecycle := New(func(ctx context.Context) error {
+		return errors.New("database unavailable")
+	}, options(50*time.Millisecond), ctx)
+	lifecycle.Start()
+
+	err := lifecycle.Wait(context.Background())
+
+	notReady, ok := err.(*NotReadyError)
+	assert.True(t, ok)
+	assert.EqualValues(t, "the function failed to initialize: database unavailable", notReady.Error())
+	assert.EqualValues(t, time.Second, notReady.RetryAfter)
+	assert.False(t, lifecycle.Ready())
+}
+
+func TestWaitReturnsWhenTheRequestIsCanceled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	lifecycle := New(func(ctx context.Context) error {
+		<-release
+		return nil
+	}, options(time.Minute), context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := lifecycle.Wait(ctx)
+
+	notReady, ok := err.(*NotReadyError)
+	assert.True(t, ok)
+	assert.EqualValues(t, "the function is initializing", notReady.Error())
+}
+
+func TestRetryAfterFollowsTheBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	failed := make(chan struct{}, 1)
+	lifecycle := New(func(ctx context.Context) error {
+		select {
+		case failed <- struct{}{}:
+		default:
+		}
+		return errors.New("database unavailable")
+	}, &Options{RetryBackoff: time.Minute, RetryMaxBackoff: time.Minute}, ctx)
+	lifecycle.Start()
+	<-failed
+
+	assert.Eventually(t, func() bool {
+		notReady, ok := lifecycle.Wait(context.Background()).(*NotReadyError)
+		return ok && notReady.RetryAfter > 50*time.Second && notReady.Cause != nil
+	}, time.Second, 10*time.Millisecond)
+}
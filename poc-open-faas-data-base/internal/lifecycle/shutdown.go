@@ -0,0 +1,171 @@
+package lifecycle
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+type drainer struct {
+	name  string
+	drain func(ctx context.Context)
+}
+
+type closer struct {
+	name  string
+	close func() error
+}
+
+// Shutdown stops the function in phases: it rejects new requests, drains the in-flight ones
+// up to a grace period and then closes the clients in the order they were registered.
+type Shutdown struct {
+	mutex    sync.Mutex
+	closing  bool
+	closed   bool
+	inFlight int
+	idle     *sync.Cond
+	drainers []drainer
+	closers  []closer
+	done     chan struct{}
+}
+
+func NewShutdown() *Shutdown {
+	s := &Shutdown{done: make(chan struct{})}
+	s.idle = sync.NewCond(&s.mutex)
+	return s
+}
+
+// Enter registers a request in flight, it returns false once the shutdown started.
+// Every accepted request must call Leave.
+func (s *Shutdown) Enter() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closing {
+		return false
+	}
+
+	s.inFlight++
+	return true
+}
+
+func (s *Shutdown) Leave() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.inFlight--
+	if s.inFlight == 0 {
+		s.idle.Broadcast()
+	}
+}
+
+// OnDrain registers a server that drains its own requests next to the in-flight ones,
+// drain must return once ctx is done at the end of the grace period.
+func (s *Shutdown) OnDrain(name string, drain func(ctx context.Context)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.drainers = append(s.drainers, drainer{name: name, drain: drain})
+}
+
+// OnClose registers a client closed after draining, a client registered once the function is closed is closed right away.
+func (s *Shutdown) OnClose(name string, close func() error) {
+	s.mutex.Lock()
+	if !s.closed {
+		s.closers = append(s.closers, closer{name: name, close: close})
+		s.mutex.Unlock()
+		return
+	}
+	s.mutex.Unlock()
+
+	closeClient(closer{name: name, close: close})
+}
+
+// Done is closed once the clients are closed.
+func (s *Shutdown) Done() <-chan struct{} {
+	return s.done
+}
+
+// Shutdown runs every phase, it returns after closing the clients.
+// A second call waits for the first one.
+func (s *Shutdown) Shutdown(gracePeriod time.Duration) {
+	s.mutex.Lock()
+	if s.closing {
+		s.mutex.Unlock()
+		<-s.done
+		return
+	}
+	s.closing = true
+	drainers := s.drainers
+	s.mutex.Unlock()
+
+	log.Printf("Shutdown started, new requests are rejected\n")
+
+	ctx, cancel := context.WithTimeout(context.Background(), gracePeriod)
+	defer cancel()
+
+	wait := sync.WaitGroup{}
+	for _, d := range drainers {
+		wait.Add(1)
+		go func(d drainer) {
+			defer wait.Done()
+			d.drain(ctx)
+			log.Printf("Shutdown drained %s\n", d.name)
+		}(d)
+	}
+
+	if pending := s.drain(ctx); pending > 0 {
+		log.Printf("Shutdown grace period of %s expired with %d requests in flight\n", gracePeriod, pending)
+	} else {
+		log.Printf("Shutdown drained the requests in flight\n")
+	}
+
+	wait.Wait()
+
+	s.mutex.Lock()
+	s.closed = true
+	closers := s.closers
+	s.mutex.Unlock()
+
+	for _, c := range closers {
+		closeClient(c)
+	}
+
+	log.Printf("Shutdown completed\n")
+	close(s.done)
+}
+
+// drain waits until no request is in flight or ctx is done, it returns the requests still in flight.
+func (s *Shutdown) drain(ctx context.Context) int {
+	drained := make(chan struct{})
+	defer close(drained)
+
+	// wakes the wait below when the grace period expires.
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			s.idle.Broadcast()
+		case <-drained:
+		}
+	}()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for s.inFlight > 0 && ctx.Err() == nil {
+		s.idle.Wait()
+	}
+
+	return s.inFlight
+}
+
+func closeClient(c closer) {
+	if err := c.close(); err != nil {
+		log.Printf("Shutdown error closing %s %s\n", c.name, err.Error())
+		return
+	}
+	log.Printf("Shutdown closed %s\n", c.name)
+}
@@ -0,0 +1,95 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestShutdownDrainsBeforeClosing(t *testing.T) {
+	shutdown := NewShutdown()
+
+	events := []string{}
+	eventsMutex := sync.Mutex{}
+	record := func(event string) {
+		eventsMutex.Lock()
+		defer eventsMutex.Unlock()
+		events = append(events, event)
+	}
+
+	shutdown.OnClose("cache client", func() error {
+		record("cache closed")
+		return nil
+	})
+	shutdown.OnClose("database pool", func() error {
+		record("database closed")
+		return errors.New("already closed")
+	})
+	shutdown.OnDrain("grpc server", func(ctx context.Context) {
+		record("grpc drained")
+	})
+
+	assert.True(t, shutdown.Enter())
+
+	done := make(chan struct{})
+	go func() {
+		shutdown.Shutdown(time.Minute)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool { return !shutdown.Enter() }, time.Second, time.Millisecond)
+
+	select {
+	case <-done:
+		t.Fatal("shutdown completed with a request in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	record("request finished")
+	shutdown.Leave()
+	<-done
+	<-shutdown.Done()
+
+	assert.EqualValues(t, []string{"grpc drained", "request finished", "cache closed", "database closed"}, events)
+}
+
+func TestShutdownGracePeriodExpires(t *testing.T) {
+	shutdown := NewShutdown()
+
+	drained := make(chan error, 1)
+	shutdown.OnDrain("grpc server", func(ctx context.Context) {
+		<-ctx.Done()
+		drained <- ctx.Err()
+	})
+
+	closed := false
+	shutdown.OnClose("database pool", func() error {
+		closed = true
+		return nil
+	})
+
+	assert.True(t, shutdown.Enter())
+
+	shutdown.Shutdown(50 * time.Millisecond)
+
+	assert.EqualValues(t, context.DeadlineExceeded, <-drained)
+	assert.True(t, closed)
+}
+
+func TestShutdownClosesLateClients(t *testing.T) {
+	shutdown := NewShutdown()
+	shutdown.Shutdown(time.Second)
+	shutdown.Shutdown(time.Second)
+
+	closed := false
+	shutdown.OnClose("database pool", func() error {
+		closed = true
+		return nil
+	})
+
+	assert.True(t, closed)
+	assert.False(t, shutdown.Enter())
+}